@@ -13,17 +13,139 @@ type ChiaCASpec struct {
 	// Secret defines the name of the secret to contain CA files
 	// +optional
 	Secret string `json:"secret,omitempty"`
+
+	// Backend is the system that issues the private CA. "Operator" generates the CA in the operator,
+	// "CertManager" creates a cert-manager Certificate for the CA and copies the issued CA into the CA Secret. Defaults to Operator.
+	// +optional
+	// +kubebuilder:default=Operator
+	Backend CertificateBackend `json:"backend,omitempty"`
+
+	// IssuerRef references the cert-manager issuer that signs the private CA, which could be an intermediate CA of your own root.
+	// Only used with the CertManager backend. Defaults to a self-signed Issuer created by the operator.
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// Adopt configures an existing private CA to use in the CA Secret, instead of generating a new one.
+	// +optional
+	Adopt *ChiaCAAdopt `json:"adopt,omitempty"`
+
+	// RepairPolicy is what to do when the CA Secret is found to be missing data or contains invalid certificates or keys.
+	// "None" only reports the problem in a Degraded condition, "Regenerate" regenerates the invalid contents of the Secret. Defaults to None.
+	// +optional
+	// +kubebuilder:default=None
+	RepairPolicy SecretRepairPolicy `json:"repairPolicy,omitempty"`
+
+	// Rotation configures a staged rotation of the private CA in the CA Secret.
+	// +optional
+	Rotation *ChiaCARotation `json:"rotation,omitempty"`
+}
+
+// ChiaCAAdopt configures an existing private CA certificate and key to adopt into the CA Secret.
+// Either SecretRef, or both Certificate and PrivateKey, should be set.
+type ChiaCAAdopt struct {
+	// SecretRef references a Secret in the same namespace that contains the existing private CA certificate and key.
+	// +optional
+	SecretRef *ChiaCAAdoptSecretRef `json:"secretRef,omitempty"`
+
+	// Certificate is an inline PEM encoded private CA certificate.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+
+	// PrivateKey is an inline PEM encoded private CA key.
+	// Prefer SecretRef, since the key will be readable by anyone that can read this ChiaCA.
+	// +optional
+	PrivateKey string `json:"privateKey,omitempty"`
+}
+
+// ChiaCAAdoptSecretRef references a Secret containing an existing private CA certificate and key
+type ChiaCAAdoptSecretRef struct {
+	// Name is the name of the Secret
+	Name string `json:"name"`
+
+	// CertificateKey is the key in the Secret containing the PEM encoded private CA certificate. Defaults to "private_ca.crt"
+	// +optional
+	CertificateKey string `json:"certificateKey,omitempty"`
+
+	// PrivateKeyKey is the key in the Secret containing the PEM encoded private CA key. Defaults to "private_ca.key"
+	// +optional
+	PrivateKeyKey string `json:"privateKeyKey,omitempty"`
 }
 
+// ChiaCARotation configures a staged rotation of the private CA.
+// During a rotation both the old and new private CA are trusted until every consumer of the CA Secret has been rolled with the new CA.
+type ChiaCARotation struct {
+	// Revision is an arbitrary identifier for the desired private CA.
+	// Changing this to a value that differs from the status's revision starts a rotation to a newly generated private CA.
+	Revision string `json:"revision"`
+
+	// TransitionPeriod is the minimum amount of time each stage of a rotation lasts before moving to the next stage.
+	// Defaults to 1h.
+	// +optional
+	TransitionPeriod *metav1.Duration `json:"transitionPeriod,omitempty"`
+}
+
+// ChiaCARotationStage is a stage of a private CA rotation
+type ChiaCARotationStage string
+
+const (
+	// ChiaCARotationStageTrustBundle is the first stage of a rotation, where a new private CA was generated and a trust bundle containing the old and new CA was published.
+	// The old CA is still used for signing certificates.
+	ChiaCARotationStageTrustBundle ChiaCARotationStage = "TrustBundle"
+
+	// ChiaCARotationStageReissue is the second stage of a rotation, where the new CA is used for signing and dependent ChiaCertificates are re-issued.
+	// The old CA is still trusted.
+	ChiaCARotationStageReissue ChiaCARotationStage = "Reissue"
+
+	// ChiaCARotationStageRetire is the third stage of a rotation, where the old CA was removed from the trust bundle.
+	ChiaCARotationStageRetire ChiaCARotationStage = "Retire"
+
+	// ChiaCARotationStageComplete means the last rotation finished and all consumers rolled with only the new CA.
+	ChiaCARotationStageComplete ChiaCARotationStage = "Complete"
+)
+
 // ChiaCAStatus defines the observed state of ChiaCA
 type ChiaCAStatus struct {
 	// Ready says whether the CA is ready, this should be true when the SSL secret is in the target namespace
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// Revision is the rotation revision of the private CA currently in use for signing certificates.
+	// With the CertManager backend, this is the serial number of the private CA cert-manager issued.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Rotation contains the state of the current or last private CA rotation
+	// +optional
+	Rotation *ChiaCARotationStatus `json:"rotation,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ChiaCARotationStatus contains the state of a private CA rotation
+type ChiaCARotationStatus struct {
+	// Stage is the current stage of the rotation
+	Stage ChiaCARotationStage `json:"stage"`
+
+	// TargetRevision is the rotation revision being rotated to
+	// +optional
+	TargetRevision string `json:"targetRevision,omitempty"`
+
+	// StageStartTime is the time the current stage began
+	// +optional
+	StageStartTime *metav1.Time `json:"stageStartTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // ChiaCA is the Schema for the chiacas API
 type ChiaCA struct {
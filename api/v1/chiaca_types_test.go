@@ -25,6 +25,11 @@ metadata:
   name: chiaca-sample
 spec:
   secret: chiaca-secret
+  adopt:
+    secretRef:
+      name: existing-ca
+      certificateKey: ca.crt
+      privateKeyKey: ca.key
 `)
 
 	expect := ChiaCA{
@@ -43,6 +48,13 @@ spec:
 		},
 		Spec: ChiaCASpec{
 			Secret: "chiaca-secret",
+			Adopt: &ChiaCAAdopt{
+				SecretRef: &ChiaCAAdoptSecretRef{
+					Name:           "existing-ca",
+					CertificateKey: "ca.crt",
+					PrivateKeyKey:  "ca.key",
+				},
+			},
 		},
 	}
 
@@ -16,6 +16,31 @@ type ChiaCertificatesSpec struct {
 
 	// CASecretName is the name of a Secret in the same namespace that contains the private Chia CA
 	CASecretName string `json:"caSecretName"`
+
+	// Backend is the system that issues the certificates. "Operator" generates the certificates in the operator,
+	// "CertManager" creates cert-manager Issuers from the CA Secret and a cert-manager Certificate for each service, and assembles the issued certificates into the Secret.
+	// Defaults to Operator.
+	// +optional
+	// +kubebuilder:default=Operator
+	Backend CertificateBackend `json:"backend,omitempty"`
+
+	// Services limits the certificate-key pairs in the Secret to the listed services, such as private_harvester and private_daemon for a remote harvester.
+	// Defaults to every Chia service's public and private certificate-key pairs.
+	// +optional
+	// +kubebuilder:validation:items:Enum=private_crawler;private_daemon;private_data_layer;public_data_layer;private_farmer;public_farmer;private_full_node;public_full_node;private_harvester;public_introducer;private_timelord;public_timelord;private_wallet;public_wallet
+	Services []string `json:"services,omitempty"`
+
+	// RenewBefore is how long before the earliest certificate expiration the certificates in the Secret should be regenerated.
+	// Defaults to 720h (30 days). If it's longer than the lifetime of the renewed certificates, the certificates aren't renewed and the resource is marked Degraded.
+	// The certificates the operator generates expire on 2100-08-02.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// RepairPolicy is what to do when the certificates Secret is found to be missing data or contains invalid certificates or keys.
+	// "None" only reports the problem in a Degraded condition, "Regenerate" regenerates the invalid contents of the Secret. Defaults to None.
+	// +optional
+	// +kubebuilder:default=None
+	RepairPolicy SecretRepairPolicy `json:"repairPolicy,omitempty"`
 }
 
 // ChiaCertificatesStatus defines the observed state of ChiaCertificates.
@@ -23,10 +48,33 @@ type ChiaCertificatesStatus struct {
 	// Ready says whether the ChiaCertificates is ready, this should be true when the SSL secret is in the target namespace
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// NotAfter is the earliest expiration time of all the certificates in the Secret
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is the time at which the certificates in the Secret will be regenerated
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+
+	// LastRenewalTime is the last time the certificates in the Secret were regenerated after initially being created
+	// +optional
+	LastRenewalTime *metav1.Time `json:"lastRenewalTime,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 
 // ChiaCertificates is the Schema for the chiacertificates API.
 type ChiaCertificates struct {
@@ -4,7 +4,13 @@ Copyright 2023 Chia Network Inc.
 
 package v1
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
 
 // CommonSpec represents the common configuration options for controller APIs at the top-spec level
 type CommonSpec struct {
@@ -54,6 +60,16 @@ type CommonSpec struct {
 	// TopologySpreadConstraints describes how a group of pods ought to spread across topology domains.
 	// +optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodTemplate is a partial pod template, which is applied as a strategic merge patch over the pod template the operator generates.
+	// It can set any pod field that doesn't have an option of its own, such as tolerations, priorityClassName, dnsConfig, hostNetwork, runtimeClassName, terminationGracePeriodSeconds or hostAliases.
+	// Containers are merged by name, so the chia container can be patched through a container named "chia".
+	// The labels the operator selects pods by shouldn't be changed.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
 }
 
 // ExtraContainer allows defining a container spec that will share the kubernetes Pod alongside a Chia container, or run as an init container, along with some additional Pod spec configuration
@@ -124,6 +140,11 @@ type CommonSpecChia struct {
 	// +optional
 	SelfHostname *string `json:"selfHostname,omitempty"`
 
+	// CertificatesSecretName is the name of a Secret in the same namespace containing pre-generated Chia certificate-key pairs, such as one created by a ChiaCertificates.
+	// If set, the certificates are mounted into CHIA_ROOT/config/ssl along with the CA, instead of the chia container generating its own certificates at startup.
+	// +optional
+	CertificatesSecretName *string `json:"certificatesSecretName,omitempty"`
+
 	// PeerService defines settings for the default Service installed with any Chia component resource.
 	// This Service usually contains ports for peer connections, or in the case of seeders port 53.
 	// This Service will default to being enabled with a ClusterIP Service type.
@@ -276,6 +297,52 @@ type Service struct {
 	RollIntoPeerService *bool `json:"rollIntoPeerService,omitempty"`
 }
 
+// PodDisruptionBudgetConfig contains kubernetes PodDisruptionBudget related configuration options
+type PodDisruptionBudgetConfig struct {
+	// Enabled is a boolean selector for a PodDisruptionBudget if it should be generated.
+	// Defaults to disabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number or percentage of pods that must remain available during a voluntary disruption, such as a node drain.
+	// Mutually exclusive with maxUnavailable.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of pods that can be unavailable during a voluntary disruption, such as a node drain.
+	// Mutually exclusive with minAvailable. Defaults to 1 if neither is set.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AutoscalingConfig contains kubernetes HorizontalPodAutoscaler related configuration options
+type AutoscalingConfig struct {
+	// Enabled is a boolean selector for a HorizontalPodAutoscaler if it should be generated.
+	// The HorizontalPodAutoscaler sets the number of replicas instead of the replicas field while it's enabled.
+	// Defaults to disabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinReplicas is the lower limit for the number of replicas the HorizontalPodAutoscaler can scale down to. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas the HorizontalPodAutoscaler can scale up to. Required if autoscaling is enabled.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+
+	// Metrics contains the specifications used to calculate the desired replica count.
+	// Defaults to 80% average CPU utilization.
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior of the HorizontalPodAutoscaler in both up and down directions
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
 // StorageConfig contains storage configuration settings
 type StorageConfig struct {
 	// Storage configuration for CHIA_ROOT
@@ -292,7 +359,7 @@ type StorageConfig struct {
 }
 
 // ChiaRootConfig optional config for CHIA_ROOT persistent storage, likely only needed for Chia full_nodes, but may help in startup time for other components.
-// Both options may be specified but only one can be used, therefore PersistentVolumeClaims will be respected over HostPath volumes if both are specified.
+// Only one of the options may be specified. If both are, which the validating webhook rejects, PersistentVolumeClaims are respected over HostPath volumes.
 type ChiaRootConfig struct {
 	// PersistentVolumeClaim use an existing persistent volume claim to store CHIA_ROOT data
 	// +optional
@@ -316,7 +383,7 @@ type PlotsConfig struct {
 }
 
 // DataLayerServerFilesConfig optional config for data_layer server file persistent storage.
-// Both options may be specified but only one can be used, therefore PersistentVolumeClaims will be respected over HostPath volumes if both are specified.
+// Only one of the options may be specified. If both are, which the validating webhook rejects, PersistentVolumeClaims are respected over HostPath volumes.
 type DataLayerServerFilesConfig struct {
 	// PersistentVolumeClaim use an existing persistent volume claim to store server files
 	// +optional
@@ -371,3 +438,162 @@ type Peer struct {
 	// Port is the port number the full_node's peer port is listening on.
 	Port uint16 `json:"port"`
 }
+
+// SecretRepairPolicy describes what the operator does when a Secret it generated is found to be missing data or invalid
+// +kubebuilder:validation:Enum=None;Regenerate
+type SecretRepairPolicy string
+
+const (
+	// SecretRepairPolicyNone only reports problems found in a Secret with a Degraded condition
+	SecretRepairPolicyNone SecretRepairPolicy = "None"
+
+	// SecretRepairPolicyRegenerate regenerates the invalid contents of a Secret
+	SecretRepairPolicyRegenerate SecretRepairPolicy = "Regenerate"
+)
+
+const (
+	// ConditionTypeReady indicates that a resource's subresources are reconciled and available
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeProgressing indicates that a resource is working towards its desired state
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeDegraded indicates that a resource is running, but something it manages is in an unhealthy state
+	ConditionTypeDegraded = "Degraded"
+)
+
+// WorkloadStatus reports the rollout of the Deployment or StatefulSet that runs a Chia component
+type WorkloadStatus struct {
+	// Replicas is the number of pods the workload is running
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of the workload's pods that are ready
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of the workload's pods that have been ready for at least their minReadySeconds
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// UpdatedReplicas is the number of the workload's pods that are running its latest pod template
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Images are the chia container images currently running in the workload's pods. There is more than one during a rollout.
+	// +optional
+	Images []string `json:"images,omitempty"`
+
+	// FailingPods lists the workload's pods that can't start or keep running
+	// +optional
+	FailingPods []FailingPod `json:"failingPods,omitempty"`
+
+	// Recreation reports the workload being recreated, because its selector changed and can't be updated in place
+	// +optional
+	Recreation *WorkloadRecreation `json:"recreation,omitempty"`
+}
+
+// WorkloadRecreationPhase is a step of recreating a workload
+// +kubebuilder:validation:Enum=Deleting;Adopting
+type WorkloadRecreationPhase string
+
+const (
+	// WorkloadRecreationDeleting means the old workload is being deleted, with the objects it owns orphaned so its pods keep running
+	WorkloadRecreationDeleting WorkloadRecreationPhase = "Deleting"
+
+	// WorkloadRecreationAdopting means the old workload is gone, and the objects it orphaned are being relabeled for the new workload to adopt
+	WorkloadRecreationAdopting WorkloadRecreationPhase = "Adopting"
+)
+
+// WorkloadRecreation reports the recreation of a Deployment or StatefulSet whose selector changed.
+// The old workload is deleted without its pods, which are adopted by the new workload, so they keep running through the recreation.
+type WorkloadRecreation struct {
+	// Phase is the step the recreation is on
+	Phase WorkloadRecreationPhase `json:"phase"`
+
+	// Selector is the label selector of the old workload, which the objects it orphaned are found with
+	// +optional
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// StartTime is when the recreation started
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// FailingPod describes a pod that can't start or keep running
+type FailingPod struct {
+	// Name is the name of the pod
+	Name string `json:"name"`
+
+	// Reason is a brief CamelCase reason for the failure, such as CrashLoopBackOff or Unschedulable
+	Reason string `json:"reason"`
+
+	// Message is a human readable description of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CrawlerPeerStatus reports the peers a crawler has found on the network, from its RPC
+type CrawlerPeerStatus struct {
+	// Total is the number of peers the crawler has seen in the last 5 days
+	// +optional
+	Total int32 `json:"total,omitempty"`
+
+	// Reliable is the number of peers the crawler considers reliable
+	// +optional
+	Reliable int32 `json:"reliable,omitempty"`
+
+	// IPv4 is the number of peers with an IPv4 address the crawler has seen in the last 5 days
+	// +optional
+	IPv4 int32 `json:"ipv4,omitempty"`
+
+	// IPv6 is the number of peers with an IPv6 address the crawler has seen in the last 5 days
+	// +optional
+	IPv6 int32 `json:"ipv6,omitempty"`
+
+	// SeenLastHour is the number of peers the crawler has seen in the last hour
+	// +optional
+	SeenLastHour int32 `json:"seenLastHour,omitempty"`
+
+	// Versions lists the number of peers running each Chia version, sorted by version
+	// +optional
+	Versions []CrawlerPeerVersion `json:"versions,omitempty"`
+
+	// Error is set if the crawler RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CrawlerPeerVersion is the number of peers running a Chia version
+type CrawlerPeerVersion struct {
+	// Version is the Chia version the peers reported
+	Version string `json:"version"`
+
+	// Count is the number of peers running the version
+	Count int32 `json:"count"`
+}
+
+// CertificateBackend is the system that issues the certificates for ChiaCA and ChiaCertificates resources
+// +kubebuilder:validation:Enum=Operator;CertManager
+type CertificateBackend string
+
+const (
+	// CertificateBackendOperator has the operator generate certificates itself
+	CertificateBackendOperator CertificateBackend = "Operator"
+
+	// CertificateBackendCertManager has the operator create cert-manager Issuers and Certificates, and assemble the Secrets cert-manager issues
+	CertificateBackendCertManager CertificateBackend = "CertManager"
+)
+
+// CertManagerIssuerRef references a cert-manager Issuer or ClusterIssuer
+type CertManagerIssuerRef struct {
+	// Name is the name of the Issuer or ClusterIssuer
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer, either Issuer or ClusterIssuer. Defaults to Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group is the API group of the issuer. Defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
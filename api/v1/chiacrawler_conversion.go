@@ -0,0 +1,8 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+// Hub marks this type as a conversion hub
+func (*ChiaCrawler) Hub() {}
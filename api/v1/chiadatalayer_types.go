@@ -88,6 +88,20 @@ type FileserverConfig struct {
 	// +optional
 	Ingress IngressConfig `json:"ingress,omitempty"`
 
+	// Replicas is the desired number of replicas of the fileserver.
+	// Setting replicas, or enabling autoscaling, runs the fileserver in a Deployment of its own instead of as a sidecar, which needs a ReadWriteMany persistentVolumeClaim for the data_layer server files.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Autoscaling defines settings for an optional HorizontalPodAutoscaler for the fileserver's own Deployment.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget for the fileserver's own Deployment.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+
 	// AdditionalEnv contain a list of additional environment variables to be supplied to the chia container.
 	// These variables will be placed at the end of the environment variable list in the resulting container,
 	// this means they overwrite variables of the same name created by the operator in the container env.
@@ -142,13 +156,31 @@ type IngressConfig struct {
 
 // ChiaDataLayerStatus defines the observed state of ChiaDataLayer
 type ChiaDataLayerStatus struct {
-	// Ready says whether the chia component is ready, this should be true when the data_layer resource is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Fileserver reports the rollout of the fileserver's Deployment, when the fileserver runs in a Deployment of its own
+	// +optional
+	Fileserver *WorkloadStatus `json:"fileserver,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 
 // ChiaDataLayer is the Schema for the chiadatalayers API
 type ChiaDataLayer struct {
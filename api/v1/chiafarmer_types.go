@@ -6,6 +6,7 @@ package v1
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,13 +47,148 @@ type ChiaFarmerSpecChia struct {
 
 // ChiaFarmerStatus defines the observed state of ChiaFarmer
 type ChiaFarmerStatus struct {
-	// Ready says whether the node is ready, this should be true when the node statefulset is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Farming reports the farmer's connected harvesters and pools, from its RPC
+	// +optional
+	Farming *ChiaFarmerFarmingStatus `json:"farming,omitempty"`
+}
+
+// ChiaFarmerFarmingStatus reports the farmer's connected harvesters and pools, from its RPC get_harvesters_summary and get_pool_state endpoints
+type ChiaFarmerFarmingStatus struct {
+	// ConnectedHarvesters is the number of harvesters connected to the farmer
+	// +optional
+	ConnectedHarvesters int32 `json:"connectedHarvesters,omitempty"`
+
+	// PlotCount is the number of plots loaded by all of the farmer's harvesters
+	// +optional
+	PlotCount int32 `json:"plotCount,omitempty"`
+
+	// FailedPlots is the number of plot files the farmer's harvesters failed to open, or don't have the keys for
+	// +optional
+	FailedPlots int32 `json:"failedPlots,omitempty"`
+
+	// DuplicatePlots is the number of plots loaded by more than one of the farmer's harvesters
+	// +optional
+	DuplicatePlots int32 `json:"duplicatePlots,omitempty"`
+
+	// RawSpace is the total size of the plot files loaded by all of the farmer's harvesters
+	// +optional
+	RawSpace *resource.Quantity `json:"rawSpace,omitempty"`
+
+	// EffectiveSpace is the space the plots loaded by all of the farmer's harvesters are worth in the farming lottery
+	// +optional
+	EffectiveSpace *resource.Quantity `json:"effectiveSpace,omitempty"`
+
+	// LastProofTime is when the farmer last found a proof for one of its pools, in the past 24 hours
+	// +optional
+	LastProofTime *metav1.Time `json:"lastProofTime,omitempty"`
+
+	// Harvesters lists the harvesters connected to the farmer
+	// +optional
+	Harvesters []ChiaFarmerHarvesterStatus `json:"harvesters,omitempty"`
+
+	// Pools lists the farmer's state with each of its pools
+	// +optional
+	Pools []ChiaFarmerPoolStatus `json:"pools,omitempty"`
+
+	// Error is set if the farmer RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ChiaFarmerHarvesterStatus is a harvester connected to a farmer
+type ChiaFarmerHarvesterStatus struct {
+	// Host is the address the harvester is connected to the farmer from
+	Host string `json:"host"`
+
+	// NodeID is the harvester's peer node ID
+	// +optional
+	NodeID string `json:"nodeID,omitempty"`
+
+	// PlotCount is the number of plots the harvester has loaded
+	// +optional
+	PlotCount int32 `json:"plotCount,omitempty"`
+
+	// FailedPlots is the number of plot files the harvester failed to open, or doesn't have the keys for
+	// +optional
+	FailedPlots int32 `json:"failedPlots,omitempty"`
+
+	// DuplicatePlots is the number of the harvester's plots that are also loaded by another harvester
+	// +optional
+	DuplicatePlots int32 `json:"duplicatePlots,omitempty"`
+
+	// RawSpace is the total size of the harvester's plot files
+	// +optional
+	RawSpace *resource.Quantity `json:"rawSpace,omitempty"`
+
+	// EffectiveSpace is the space the harvester's plots are worth in the farming lottery
+	// +optional
+	EffectiveSpace *resource.Quantity `json:"effectiveSpace,omitempty"`
+
+	// LastSyncTime is when the harvester last synced its plot list with the farmer
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// ChiaFarmerPoolStatus is a farmer's state with one of its pools
+type ChiaFarmerPoolStatus struct {
+	// LauncherID is the launcher ID of the plot NFT farming to the pool
+	LauncherID string `json:"launcherID"`
+
+	// PoolURL is the URL of the pool
+	// +optional
+	PoolURL string `json:"poolURL,omitempty"`
+
+	// PlotCount is the number of plots farming to the pool
+	// +optional
+	PlotCount int32 `json:"plotCount,omitempty"`
+
+	// CurrentPoints is the number of points the pool has credited the farmer with
+	// +optional
+	CurrentPoints int64 `json:"currentPoints,omitempty"`
+
+	// CurrentDifficulty is the pool's current partial proof difficulty for the farmer
+	// +optional
+	CurrentDifficulty int64 `json:"currentDifficulty,omitempty"`
+
+	// PointsFound24h is the number of points the farmer found for the pool in the past 24 hours
+	// +optional
+	PointsFound24h int64 `json:"pointsFound24h,omitempty"`
+
+	// PointsAcknowledged24h is the number of points the pool acknowledged in the past 24 hours
+	// +optional
+	PointsAcknowledged24h int64 `json:"pointsAcknowledged24h,omitempty"`
+
+	// PoolErrors24h is the number of errors the pool returned in the past 24 hours
+	// +optional
+	PoolErrors24h int32 `json:"poolErrors24h,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Harvesters",type="integer",JSONPath=".status.farming.connectedHarvesters"
+//+kubebuilder:printcolumn:name="Plots",type="integer",JSONPath=".status.farming.plotCount"
+//+kubebuilder:printcolumn:name="Effective Space",type="string",JSONPath=".status.farming.effectiveSpace"
+//+kubebuilder:printcolumn:name="Last Proof",type="date",JSONPath=".status.farming.lastProofTime"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ChiaFarmer is the Schema for the chiafarmers API
 type ChiaFarmer struct {
@@ -6,6 +6,7 @@ package v1
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,13 +36,63 @@ type ChiaHarvesterSpecChia struct {
 
 // ChiaHarvesterStatus defines the observed state of ChiaHarvester
 type ChiaHarvesterStatus struct {
-	// Ready says whether the node is ready, this should be true when the node statefulset is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Plots reports the plots the harvester has loaded, from its RPC
+	// +optional
+	Plots *ChiaHarvesterPlotsStatus `json:"plots,omitempty"`
+}
+
+// ChiaHarvesterPlotsStatus reports the plots a harvester has loaded, from its RPC get_plots endpoint
+type ChiaHarvesterPlotsStatus struct {
+	// PlotCount is the number of plots the harvester has loaded
+	// +optional
+	PlotCount int32 `json:"plotCount,omitempty"`
+
+	// FailedPlots is the number of plot files the harvester failed to open
+	// +optional
+	FailedPlots int32 `json:"failedPlots,omitempty"`
+
+	// NotFoundPlots is the number of plot files the harvester expected, but couldn't find
+	// +optional
+	NotFoundPlots int32 `json:"notFoundPlots,omitempty"`
+
+	// RawSpace is the total size of the harvester's plot files
+	// +optional
+	RawSpace *resource.Quantity `json:"rawSpace,omitempty"`
+
+	// EffectiveSpace is the space the harvester's plots are worth in the farming lottery
+	// +optional
+	EffectiveSpace *resource.Quantity `json:"effectiveSpace,omitempty"`
+
+	// Error is set if the harvester RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Plots",type="integer",JSONPath=".status.plots.plotCount"
+//+kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.plots.failedPlots"
+//+kubebuilder:printcolumn:name="Effective Space",type="string",JSONPath=".status.plots.effectiveSpace"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ChiaHarvester is the Schema for the chiaharvesters API
 type ChiaHarvester struct {
@@ -34,6 +34,20 @@ type ChiaIntroducerSpec struct {
 	// Strategy describes how to replace existing pods with new ones.
 	// +optional
 	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// Replicas is the desired number of replicas of the given Deployment. defaults to 1.
+	// More than one replica can't share a persistentVolumeClaim for CHIA_ROOT unless the claim is ReadWriteMany.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Autoscaling defines settings for an optional HorizontalPodAutoscaler, which scales the Deployment between a minimum and maximum number of replicas.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
 }
 
 // ChiaIntroducerSpecChia defines the desired state of Chia component configuration
@@ -47,13 +61,27 @@ type ChiaIntroducerSpecChia struct {
 
 // ChiaIntroducerStatus defines the observed state of ChiaIntroducer
 type ChiaIntroducerStatus struct {
-	// Ready says whether the node is ready, this should be true when the node statefulset is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 
 // ChiaIntroducer is the Schema for the chiaintroducers API
 type ChiaIntroducer struct {
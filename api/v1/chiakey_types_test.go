@@ -0,0 +1,63 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestUnmarshalChiaKey(t *testing.T) {
+	yamlData := []byte(`
+apiVersion: k8s.chia.net/v1
+kind: ChiaKey
+metadata:
+  labels:
+    app.kubernetes.io/name: chiakey
+    app.kubernetes.io/instance: chiakey-sample
+    app.kubernetes.io/part-of: chia-operator
+    app.kubernetes.io/created-by: chia-operator
+  name: chiakey-sample
+spec:
+  secret: chiakey-secret
+  key: key.txt
+`)
+
+	expect := ChiaKey{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "k8s.chia.net/v1",
+			Kind:       "ChiaKey",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "chiakey-sample",
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "chiakey",
+				"app.kubernetes.io/instance":   "chiakey-sample",
+				"app.kubernetes.io/part-of":    "chia-operator",
+				"app.kubernetes.io/created-by": "chia-operator",
+			},
+		},
+		Spec: ChiaKeySpec{
+			Secret: "chiakey-secret",
+			Key:    "key.txt",
+		},
+	}
+
+	var actual ChiaKey
+	err := yaml.Unmarshal(yamlData, &actual)
+	if err != nil {
+		t.Errorf("Error unmarshaling yaml: %v", err)
+		return
+	}
+
+	diff := cmp.Diff(actual, expect)
+	if diff != "" {
+		t.Errorf("Unmarshaled struct does not match the expected struct. Actual: %+v\nExpected: %+v\nDiff: %s", actual, expect, diff)
+		return
+	}
+}
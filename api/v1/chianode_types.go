@@ -28,6 +28,10 @@ type ChiaNodeSpec struct {
 	// UpdateStrategy indicates the strategy that the StatefulSet controller will use to perform updates.
 	// +optional
 	UpdateStrategy *appsv1.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
 }
 
 // ChiaNodeSpecChia defines the desired state of Chia component configuration
@@ -49,13 +53,71 @@ type ChiaNodeSpecChia struct {
 
 // ChiaNodeStatus defines the observed state of ChiaNode
 type ChiaNodeStatus struct {
-	// Ready says whether the node is ready, this should be true when the node statefulset is in the target namespace
+	// Ready says whether the node is ready, this is true when its StatefulSet has rolled out and all of its replicas are ready
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's StatefulSet
+	WorkloadStatus `json:",inline"`
+
+	// FullNodes reports the blockchain state of each of the node's running pods, from their full_node RPC
+	// +optional
+	// +listType=map
+	// +listMapKey=pod
+	FullNodes []ChiaNodeFullNodeStatus `json:"fullNodes,omitempty"`
+}
+
+// ChiaNodeFullNodeStatus is the blockchain state of one ChiaNode pod, from its full_node RPC get_blockchain_state and get_connections endpoints
+type ChiaNodeFullNodeStatus struct {
+	// Pod is the name of the pod
+	Pod string `json:"pod"`
+
+	// PeakHeight is the height of the full_node's peak block
+	// +optional
+	PeakHeight int64 `json:"peakHeight,omitempty"`
+
+	// Synced says whether the full_node has synced to the peak of its peers
+	// +optional
+	Synced bool `json:"synced,omitempty"`
+
+	// SyncMode says whether the full_node is doing a long sync
+	// +optional
+	SyncMode bool `json:"syncMode,omitempty"`
+
+	// SyncProgressHeight is the height the full_node has synced to during a long sync
+	// +optional
+	SyncProgressHeight int64 `json:"syncProgressHeight,omitempty"`
+
+	// SyncTipHeight is the height the full_node is syncing towards during a long sync
+	// +optional
+	SyncTipHeight int64 `json:"syncTipHeight,omitempty"`
+
+	// Difficulty is the current difficulty of the blockchain
+	// +optional
+	Difficulty int64 `json:"difficulty,omitempty"`
+
+	// Connections is the number of peers the full_node is connected to
+	// +optional
+	Connections int32 `json:"connections,omitempty"`
+
+	// Error is set if the full_node RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // ChiaNode is the Schema for the chianodes API
 type ChiaNode struct {
@@ -47,13 +47,27 @@ type ChiaTimelordSpecChia struct {
 
 // ChiaTimelordStatus defines the observed state of ChiaTimelord
 type ChiaTimelordStatus struct {
-	// Ready says whether the CA is ready, this should be true when the SSL secret is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // ChiaTimelord is the Schema for the chiatimelords API
 type ChiaTimelord struct {
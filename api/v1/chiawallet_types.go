@@ -19,6 +19,11 @@ type ChiaWalletSpec struct {
 	// Strategy describes how to replace existing pods with new ones.
 	// +optional
 	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// ReportBalance can be set to true to report the balance of the wallet's standard XCH wallet in its status.
+	// Anyone who can read the ChiaWallet can see the balance, so it's disabled by default.
+	// +optional
+	ReportBalance *bool `json:"reportBalance,omitempty"`
 }
 
 // ChiaWalletSpecChia defines the desired state of Chia component configuration
@@ -56,13 +61,70 @@ type ChiaWalletSpecChia struct {
 
 // ChiaWalletStatus defines the observed state of ChiaWallet
 type ChiaWalletStatus struct {
-	// Ready says whether the node is ready, this should be true when the node statefulset is in the target namespace
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
 	// +kubebuilder:default=false
 	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Wallet reports the wallet's sync state, from its RPC
+	// +optional
+	Wallet *ChiaWalletSyncStatus `json:"wallet,omitempty"`
+}
+
+// ChiaWalletSyncStatus reports a wallet's sync state, from its RPC
+type ChiaWalletSyncStatus struct {
+	// Synced says whether the wallet has synced to the peak of its full_node peers
+	// +optional
+	Synced bool `json:"synced,omitempty"`
+
+	// Syncing says whether the wallet is syncing
+	// +optional
+	Syncing bool `json:"syncing,omitempty"`
+
+	// Height is the height the wallet has synced to
+	// +optional
+	Height int64 `json:"height,omitempty"`
+
+	// Fingerprint is the fingerprint of the key logged in to the wallet
+	// +optional
+	Fingerprint int64 `json:"fingerprint,omitempty"`
+
+	// FullNodePeers lists the full_node peers the wallet is connected to, in host:port format
+	// +optional
+	FullNodePeers []string `json:"fullNodePeers,omitempty"`
+
+	// ConfirmedBalance is the confirmed balance of the standard XCH wallet in mojos. Only reported if reportBalance is true.
+	// +optional
+	ConfirmedBalance *int64 `json:"confirmedBalance,omitempty"`
+
+	// SpendableBalance is the spendable balance of the standard XCH wallet in mojos. Only reported if reportBalance is true.
+	// +optional
+	SpendableBalance *int64 `json:"spendableBalance,omitempty"`
+
+	// Error is set if the wallet RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Synced",type="boolean",JSONPath=".status.wallet.synced"
+//+kubebuilder:printcolumn:name="Height",type="integer",JSONPath=".status.wallet.height"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ChiaWallet is the Schema for the chiawallets API
 type ChiaWallet struct {
@@ -23,9 +23,12 @@ package v1
 import (
 	"github.com/chia-network/go-chia-libs/pkg/config"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -57,13 +60,65 @@ func (in *AdditionalMetadata) DeepCopy() *AdditionalMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCA) DeepCopyInto(out *ChiaCA) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCA.
@@ -84,6 +139,41 @@ func (in *ChiaCA) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAAdopt) DeepCopyInto(out *ChiaCAAdopt) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ChiaCAAdoptSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAAdopt.
+func (in *ChiaCAAdopt) DeepCopy() *ChiaCAAdopt {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAAdopt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAAdoptSecretRef) DeepCopyInto(out *ChiaCAAdoptSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAAdoptSecretRef.
+func (in *ChiaCAAdoptSecretRef) DeepCopy() *ChiaCAAdoptSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAAdoptSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCAList) DeepCopyInto(out *ChiaCAList) {
 	*out = *in
@@ -116,9 +206,63 @@ func (in *ChiaCAList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCARotation) DeepCopyInto(out *ChiaCARotation) {
+	*out = *in
+	if in.TransitionPeriod != nil {
+		in, out := &in.TransitionPeriod, &out.TransitionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCARotation.
+func (in *ChiaCARotation) DeepCopy() *ChiaCARotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCARotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCARotationStatus) DeepCopyInto(out *ChiaCARotationStatus) {
+	*out = *in
+	if in.StageStartTime != nil {
+		in, out := &in.StageStartTime, &out.StageStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCARotationStatus.
+func (in *ChiaCARotationStatus) DeepCopy() *ChiaCARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCASpec) DeepCopyInto(out *ChiaCASpec) {
 	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(ChiaCAAdopt)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ChiaCARotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCASpec.
@@ -134,6 +278,18 @@ func (in *ChiaCASpec) DeepCopy() *ChiaCASpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCAStatus) DeepCopyInto(out *ChiaCAStatus) {
 	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ChiaCARotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAStatus.
@@ -151,8 +307,8 @@ func (in *ChiaCertificates) DeepCopyInto(out *ChiaCertificates) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificates.
@@ -208,6 +364,16 @@ func (in *ChiaCertificatesList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCertificatesSpec) DeepCopyInto(out *ChiaCertificatesSpec) {
 	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificatesSpec.
@@ -223,6 +389,25 @@ func (in *ChiaCertificatesSpec) DeepCopy() *ChiaCertificatesSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCertificatesStatus) DeepCopyInto(out *ChiaCertificatesStatus) {
 	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRenewalTime != nil {
+		in, out := &in.LastRenewalTime, &out.LastRenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificatesStatus.
@@ -241,7 +426,7 @@ func (in *ChiaCrawler) DeepCopyInto(out *ChiaCrawler) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawler.
@@ -304,6 +489,16 @@ func (in *ChiaCrawlerSpec) DeepCopyInto(out *ChiaCrawlerSpec) {
 		*out = new(appsv1.DeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerSpec.
@@ -340,6 +535,19 @@ func (in *ChiaCrawlerSpecChia) DeepCopy() *ChiaCrawlerSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaCrawlerStatus) DeepCopyInto(out *ChiaCrawlerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = new(CrawlerPeerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerStatus.
@@ -358,7 +566,7 @@ func (in *ChiaDataLayer) DeepCopyInto(out *ChiaDataLayer) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayer.
@@ -482,6 +690,19 @@ func (in *ChiaDataLayerSpecChia) DeepCopy() *ChiaDataLayerSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaDataLayerStatus) DeepCopyInto(out *ChiaDataLayerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Fileserver != nil {
+		in, out := &in.Fileserver, &out.Fileserver
+		*out = new(WorkloadStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayerStatus.
@@ -500,7 +721,7 @@ func (in *ChiaFarmer) DeepCopyInto(out *ChiaFarmer) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmer.
@@ -521,6 +742,76 @@ func (in *ChiaFarmer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerFarmingStatus) DeepCopyInto(out *ChiaFarmerFarmingStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastProofTime != nil {
+		in, out := &in.LastProofTime, &out.LastProofTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Harvesters != nil {
+		in, out := &in.Harvesters, &out.Harvesters
+		*out = make([]ChiaFarmerHarvesterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]ChiaFarmerPoolStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerFarmingStatus.
+func (in *ChiaFarmerFarmingStatus) DeepCopy() *ChiaFarmerFarmingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerFarmingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerHarvesterStatus) DeepCopyInto(out *ChiaFarmerHarvesterStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerHarvesterStatus.
+func (in *ChiaFarmerHarvesterStatus) DeepCopy() *ChiaFarmerHarvesterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerHarvesterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaFarmerList) DeepCopyInto(out *ChiaFarmerList) {
 	*out = *in
@@ -553,6 +844,21 @@ func (in *ChiaFarmerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerPoolStatus) DeepCopyInto(out *ChiaFarmerPoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerPoolStatus.
+func (in *ChiaFarmerPoolStatus) DeepCopy() *ChiaFarmerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaFarmerSpec) DeepCopyInto(out *ChiaFarmerSpec) {
 	*out = *in
@@ -609,6 +915,19 @@ func (in *ChiaFarmerSpecChia) DeepCopy() *ChiaFarmerSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaFarmerStatus) DeepCopyInto(out *ChiaFarmerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Farming != nil {
+		in, out := &in.Farming, &out.Farming
+		*out = new(ChiaFarmerFarmingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerStatus.
@@ -627,7 +946,7 @@ func (in *ChiaHarvester) DeepCopyInto(out *ChiaHarvester) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvester.
@@ -680,6 +999,31 @@ func (in *ChiaHarvesterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterPlotsStatus) DeepCopyInto(out *ChiaHarvesterPlotsStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterPlotsStatus.
+func (in *ChiaHarvesterPlotsStatus) DeepCopy() *ChiaHarvesterPlotsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterPlotsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaHarvesterSpec) DeepCopyInto(out *ChiaHarvesterSpec) {
 	*out = *in
@@ -721,6 +1065,19 @@ func (in *ChiaHarvesterSpecChia) DeepCopy() *ChiaHarvesterSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaHarvesterStatus) DeepCopyInto(out *ChiaHarvesterStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Plots != nil {
+		in, out := &in.Plots, &out.Plots
+		*out = new(ChiaHarvesterPlotsStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterStatus.
@@ -739,7 +1096,7 @@ func (in *ChiaIntroducer) DeepCopyInto(out *ChiaIntroducer) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducer.
@@ -802,6 +1159,16 @@ func (in *ChiaIntroducerSpec) DeepCopyInto(out *ChiaIntroducerSpec) {
 		*out = new(appsv1.DeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerSpec.
@@ -838,6 +1205,14 @@ func (in *ChiaIntroducerSpecChia) DeepCopy() *ChiaIntroducerSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaIntroducerStatus) DeepCopyInto(out *ChiaIntroducerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerStatus.
@@ -850,13 +1225,109 @@ func (in *ChiaIntroducerStatus) DeepCopy() *ChiaIntroducerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKey) DeepCopyInto(out *ChiaKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKey.
+func (in *ChiaKey) DeepCopy() *ChiaKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeyList) DeepCopyInto(out *ChiaKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeyList.
+func (in *ChiaKeyList) DeepCopy() *ChiaKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeySpec) DeepCopyInto(out *ChiaKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeySpec.
+func (in *ChiaKeySpec) DeepCopy() *ChiaKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeyStatus) DeepCopyInto(out *ChiaKeyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeyStatus.
+func (in *ChiaKeyStatus) DeepCopy() *ChiaKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaNetwork) DeepCopyInto(out *ChiaNetwork) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetwork.
@@ -957,6 +1428,13 @@ func (in *ChiaNetworkSpec) DeepCopy() *ChiaNetworkSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaNetworkStatus) DeepCopyInto(out *ChiaNetworkStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetworkStatus.
@@ -975,7 +1453,7 @@ func (in *ChiaNode) DeepCopyInto(out *ChiaNode) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNode.
@@ -996,6 +1474,21 @@ func (in *ChiaNode) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeFullNodeStatus) DeepCopyInto(out *ChiaNodeFullNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeFullNodeStatus.
+func (in *ChiaNodeFullNodeStatus) DeepCopy() *ChiaNodeFullNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeFullNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaNodeList) DeepCopyInto(out *ChiaNodeList) {
 	*out = *in
@@ -1039,6 +1532,11 @@ func (in *ChiaNodeSpec) DeepCopyInto(out *ChiaNodeSpec) {
 		*out = new(appsv1.StatefulSetUpdateStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeSpec.
@@ -1088,6 +1586,19 @@ func (in *ChiaNodeSpecChia) DeepCopy() *ChiaNodeSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaNodeStatus) DeepCopyInto(out *ChiaNodeStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.FullNodes != nil {
+		in, out := &in.FullNodes, &out.FullNodes
+		*out = make([]ChiaNodeFullNodeStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeStatus.
@@ -1146,7 +1657,7 @@ func (in *ChiaSeeder) DeepCopyInto(out *ChiaSeeder) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeeder.
@@ -1210,6 +1721,16 @@ func (in *ChiaSeederSpec) DeepCopyInto(out *ChiaSeederSpec) {
 		*out = new(appsv1.DeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederSpec.
@@ -1270,6 +1791,19 @@ func (in *ChiaSeederSpecChia) DeepCopy() *ChiaSeederSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaSeederStatus) DeepCopyInto(out *ChiaSeederStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = new(CrawlerPeerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederStatus.
@@ -1288,7 +1822,7 @@ func (in *ChiaTimelord) DeepCopyInto(out *ChiaTimelord) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelord.
@@ -1397,6 +1931,14 @@ func (in *ChiaTimelordSpecChia) DeepCopy() *ChiaTimelordSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaTimelordStatus) DeepCopyInto(out *ChiaTimelordStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelordStatus.
@@ -1415,7 +1957,7 @@ func (in *ChiaWallet) DeepCopyInto(out *ChiaWallet) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWallet.
@@ -1478,6 +2020,11 @@ func (in *ChiaWalletSpec) DeepCopyInto(out *ChiaWalletSpec) {
 		*out = new(appsv1.DeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReportBalance != nil {
+		in, out := &in.ReportBalance, &out.ReportBalance
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletSpec.
@@ -1543,6 +2090,19 @@ func (in *ChiaWalletSpecChia) DeepCopy() *ChiaWalletSpecChia {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChiaWalletStatus) DeepCopyInto(out *ChiaWalletStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Wallet != nil {
+		in, out := &in.Wallet, &out.Wallet
+		*out = new(ChiaWalletSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletStatus.
@@ -1555,6 +2115,36 @@ func (in *ChiaWalletStatus) DeepCopy() *ChiaWalletStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletSyncStatus) DeepCopyInto(out *ChiaWalletSyncStatus) {
+	*out = *in
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfirmedBalance != nil {
+		in, out := &in.ConfirmedBalance, &out.ConfirmedBalance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SpendableBalance != nil {
+		in, out := &in.SpendableBalance, &out.SpendableBalance
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletSyncStatus.
+func (in *ChiaWalletSyncStatus) DeepCopy() *ChiaWalletSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 	*out = *in
@@ -1617,6 +2207,11 @@ func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSpec.
@@ -1687,6 +2282,11 @@ func (in *CommonSpecChia) DeepCopyInto(out *CommonSpecChia) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CertificatesSecretName != nil {
+		in, out := &in.CertificatesSecretName, &out.CertificatesSecretName
+		*out = new(string)
+		**out = **in
+	}
 	in.PeerService.DeepCopyInto(&out.PeerService)
 	in.DaemonService.DeepCopyInto(&out.DaemonService)
 	in.RPCService.DeepCopyInto(&out.RPCService)
@@ -1739,6 +2339,41 @@ func (in *CommonSpecChia) DeepCopy() *CommonSpecChia {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrawlerPeerStatus) DeepCopyInto(out *CrawlerPeerStatus) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]CrawlerPeerVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerPeerStatus.
+func (in *CrawlerPeerStatus) DeepCopy() *CrawlerPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrawlerPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrawlerPeerVersion) DeepCopyInto(out *CrawlerPeerVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerPeerVersion.
+func (in *CrawlerPeerVersion) DeepCopy() *CrawlerPeerVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(CrawlerPeerVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLayerServerFilesConfig) DeepCopyInto(out *DataLayerServerFilesConfig) {
 	*out = *in
@@ -1787,6 +2422,21 @@ func (in *ExtraContainer) DeepCopy() *ExtraContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailingPod) DeepCopyInto(out *FailingPod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailingPod.
+func (in *FailingPod) DeepCopy() *FailingPod {
+	if in == nil {
+		return nil
+	}
+	out := new(FailingPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileserverConfig) DeepCopyInto(out *FileserverConfig) {
 	*out = *in
@@ -1812,6 +2462,21 @@ func (in *FileserverConfig) DeepCopyInto(out *FileserverConfig) {
 	}
 	in.Service.DeepCopyInto(&out.Service)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalEnv != nil {
 		in, out := &in.AdditionalEnv, &out.AdditionalEnv
 		*out = new([]corev1.EnvVar)
@@ -2090,6 +2755,36 @@ func (in *PlotsConfig) DeepCopy() *PlotsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetConfig) DeepCopyInto(out *PodDisruptionBudgetConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetConfig.
+func (in *PodDisruptionBudgetConfig) DeepCopy() *PodDisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
@@ -2241,3 +2936,56 @@ func (in *StorageConfig) DeepCopy() *StorageConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadRecreation) DeepCopyInto(out *WorkloadRecreation) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadRecreation.
+func (in *WorkloadRecreation) DeepCopy() *WorkloadRecreation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadRecreation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailingPods != nil {
+		in, out := &in.FailingPods, &out.FailingPods
+		*out = make([]FailingPod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recreation != nil {
+		in, out := &in.Recreation, &out.Recreation
+		*out = new(WorkloadRecreation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
+func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaCertificates to the Hub version (v1)
+func (src *ChiaCertificates) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaCertificates)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	dst.Spec.CASecretName = src.Spec.CASecretRef.Name
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaCertificates
+func (dst *ChiaCertificates) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaCertificates)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	dst.Spec.CASecretRef = SecretReference{Name: src.Spec.CASecretName}
+	return nil
+}
@@ -0,0 +1,593 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// CommonSpec represents the common configuration options for controller APIs at the top-spec level
+type CommonSpec struct {
+	AdditionalMetadata `json:",inline"`
+
+	// ChiaExporterConfig defines the configuration options available to Chia component containers
+	// +optional
+	ChiaExporterConfig SpecChiaExporter `json:"chiaExporter,omitempty"`
+
+	// InitContainers allows defining a list of containers that will run as init containers in the kubernetes Pods this resource creates
+	// +optional
+	InitContainers []ExtraContainer `json:"initContainers,omitempty"`
+
+	// Sidecars allows defining a list of containers and volumes that will share the kubernetes Pod alongside a Chia container
+	// +optional
+	Sidecars []ExtraContainer `json:"sidecars,omitempty"`
+
+	// Storage defines the volumes the Chia container's data is stored on
+	// +optional
+	Storage *StorageConfig `json:"storage,omitempty"`
+
+	// ImagePullPolicy is the pull policy for containers in the pod
+	// +optional
+	// +kubebuilder:default="Always"
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ImagePullSecrets is a local object reference list to some image pull secrets for pod templates
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// ServiceAccountName is an optional name of a Service Account in the target namespace to use for this Chia deployment
+	// +optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+
+	// NodeSelector selects a node by key value pairs
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// PodSecurityContext defines the security context for the pod
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// Affinity defines a group of affinity or anti-affinity rules
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// TopologySpreadConstraints describes how a group of pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodTemplate is a partial pod template, which is applied as a strategic merge patch over the pod template the operator generates.
+	// It can set any pod field that doesn't have an option of its own, such as tolerations, priorityClassName, dnsConfig, hostNetwork, runtimeClassName, terminationGracePeriodSeconds or hostAliases.
+	// Containers are merged by name, so the chia container can be patched through a container named "chia".
+	// The labels the operator selects pods by shouldn't be changed.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
+}
+
+// ExtraContainer allows defining a container spec that will share the kubernetes Pod alongside a Chia container, or run as an init container, along with some additional Pod spec configuration
+type ExtraContainer struct {
+	// Container allows defining a container spec that will share the kubernetes Pod alongside a Chia container
+	// +optional
+	Container corev1.Container `json:"container,omitempty"`
+
+	// Volumes allows defining a list of volumes that can be mounted by this container
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// ShareVolumeMounts if set to true, shares any volume mounts from the main chia container to this container
+	// +optional
+	ShareVolumeMounts bool `json:"shareVolumeMounts,omitempty"`
+
+	// ShareEnv if set to true, shares the environment variables from the main chia container to this container
+	// +optional
+	ShareEnv bool `json:"shareEnv,omitempty"`
+}
+
+// CommonSpecChia represents the common configuration options for a chia spec
+type CommonSpecChia struct {
+	// Image defines the image to use for the chia component containers
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Testnet is set to true if the Chia container should switch to the latest default testnet's settings
+	// +optional
+	Testnet *bool `json:"testnet,omitempty"`
+
+	// ChiaNetwork is the name of a ChiaNetwork resource in the same namespace as this resource
+	// +optional
+	ChiaNetwork *string `json:"chiaNetwork,omitempty"`
+
+	// Network can be set to a network name in the chia configuration file to switch to
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkPort can be set to the port that full_nodes will use in the selected network.
+	// This implies specification of the Network setting.
+	// +optional
+	NetworkPort *uint16 `json:"networkPort,omitempty"`
+
+	// IntroducerAddress can be set to the hostname or IP address of an introducer to set in the chia config.
+	// No port should be specified, it's taken from the value of the NetworkPort setting.
+	// +optional
+	IntroducerAddress *string `json:"introducerAddress,omitempty"`
+
+	// DNSIntroducerAddress can be set to a hostname to a DNS Introducer server.
+	// +optional
+	DNSIntroducerAddress *string `json:"dnsIntroducerAddress,omitempty"`
+
+	// Timezone can be set to your local timezone for accurate timestamps. Defaults to UTC
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
+	// SourceRef is set to the desired ref of the chia-blockchain repository to install from. Defaults to unset (uses the installation already in the chia image.)
+	// +optional
+	SourceRef *string `json:"sourceRef,omitempty"`
+
+	// LogLevel is set to the desired chia config log_level
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+
+	// SelfHostname defines the bind address of chia services in the container
+	// Setting to `0.0.0.0` binds chia services to all interfaces
+	// +optional
+	SelfHostname *string `json:"selfHostname,omitempty"`
+
+	// CertificatesSecretRef references a Secret containing pre-generated Chia certificate-key pairs, such as one created by a ChiaCertificates.
+	// If set, the certificates are mounted into CHIA_ROOT/config/ssl along with the CA, instead of the chia container generating its own certificates at startup.
+	// +optional
+	CertificatesSecretRef *SecretReference `json:"certificatesSecretRef,omitempty"`
+
+	// PeerService defines settings for the default Service installed with any Chia component resource.
+	// This Service usually contains ports for peer connections, or in the case of seeders port 53.
+	// This Service will default to being enabled with a ClusterIP Service type.
+	// +optional
+	PeerService Service `json:"peerService,omitempty"`
+
+	// DaemonService defines settings for the daemon Service installed with any Chia component resource.
+	// This Service usually contains the port for the Chia daemon that runs alongside any Chia instance.
+	// This Service will default to being enabled with a ClusterIP Service type.
+	// +optional
+	DaemonService Service `json:"daemonService,omitempty"`
+
+	// RPCService defines settings for the RPC Service installed with any Chia component resource.
+	// This Service contains the port for the Chia RPC API.
+	// This Service will default to being enabled with a ClusterIP Service type.
+	// +optional
+	RPCService Service `json:"rpcService,omitempty"`
+
+	// AllService defines settings for a Service that contains all the ports from the peer, daemon, and RPC Services installed with any Chia component resource.
+	// This Service will default to being enabled with a ClusterIP Service type.
+	// +optional
+	AllService Service `json:"allService,omitempty"`
+
+	// AdditionalEnv contain a list of additional environment variables to be supplied to the chia container.
+	// These variables will be placed at the end of the environment variable list in the resulting container, this means they overwrite variables of the same name created by the operator in the container env.
+	// +optional
+	AdditionalEnv []corev1.EnvVar `json:"additionalEnv,omitempty"`
+
+	// LivenessProbe used to determine if a container is running properly and will restart the container if the probe fails
+	// +optional
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+
+	// ReadinessProbe used to indicate when a container is ready to accept traffic and prevent traffic from being sent to pods that aren't ready.
+	// +optional
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+
+	// StartupProbe used to give applications time to initialize fully before liveness and readiness probes begin checking, preventing premature restarts of slow-starting containers.
+	// +optional
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
+
+	// Resources defines the compute resources (limits/requests) for the chia container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// SecurityContext defines the security context for the chia container
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+}
+
+// SpecChiaExporter defines the desired state of Chia exporter configuration
+type SpecChiaExporter struct {
+	// Enabled defines whether a chia-exporter sidecar container should run with the chia container
+	// Defaults to enabled
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Image defines the image to use for the chia exporter containers
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Service defines settings for the Service installed with any chia-exporter resource.
+	// This Service contains the port for chia-exporter's web exporter.
+	// This Service will default to being enabled with a ClusterIP Service type if chia-exporter is enabled.
+	// +optional
+	Service Service `json:"service,omitempty"`
+
+	// ConfigSecretRef references an optional Secret that contains the environment variables that will be mounted in the chia-exporter container.
+	// +optional
+	ConfigSecretRef *SecretReference `json:"configSecretRef,omitempty"`
+}
+
+// SpecChiaHealthcheck defines the desired state of Chia healthcheck configuration
+type SpecChiaHealthcheck struct {
+	// Enabled defines whether a chia-exporter sidecar container should run with the chia container
+	// Defaults to enabled on services that support it
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Image defines the image to use for the chia exporter containers
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// DNSHostname is the hostname to check for DNS responses. Disabled if not provided.
+	// +optional
+	DNSHostname *string `json:"dnsHostname,omitempty"`
+
+	// Service defines settings for the Service installed with any chia-healthcheck resource.
+	// This Service contains the port for chia-healthcheck's web server.
+	// This Service will default to being disabled.
+	// +optional
+	Service Service `json:"service,omitempty"`
+}
+
+// SecretReference references a Secret in the same namespace as the resource
+type SecretReference struct {
+	// Name is the name of the Secret
+	Name string `json:"name"`
+}
+
+// SecretKeyReference references a data item of a Secret in the same namespace as the resource
+type SecretKeyReference struct {
+	// Name is the name of the Secret
+	Name string `json:"name"`
+
+	// Key is the key of the data item in the Secret
+	Key string `json:"key"`
+}
+
+// AdditionalMetadata contains labels and annotations to attach to created objects
+type AdditionalMetadata struct {
+	// Labels is a map of string keys and values to attach to created objects
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations is a map of string keys and values to attach to created objects
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Service contains kubernetes Service related configuration options
+type Service struct {
+	AdditionalMetadata `json:",inline"`
+
+	// Enabled is a boolean selector for a Service if it should be generated.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ServiceType is the Type of the Service. Defaults to ClusterIP
+	// +optional
+	ServiceType *corev1.ServiceType `json:"type,omitempty"`
+
+	// IPFamilyPolicy represents the dual-stack-ness requested or required by a Service
+	// +optional
+	IPFamilyPolicy *corev1.IPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+
+	// IPFamilies represents a list of IP families (IPv4 and/or IPv6) required by a Service
+	// +optional
+	IPFamilies []corev1.IPFamily `json:"ipFamilies,omitempty"`
+
+	// ExternalTrafficPolicy sets the external traffic policy for the service
+	// +optional
+	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// SessionAffinity can be set to "ClientIP" to enable session affinity based on client IP
+	// +optional
+	SessionAffinity *corev1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+
+	// SessionAffinityConfig allows configuring the settings for sessionAffinity
+	// +optional
+	SessionAffinityConfig *corev1.SessionAffinityConfig `json:"sessionAffinityConfig,omitempty"`
+
+	// RollIntoPeerService tells the controller to not actually generate this Service, but instead roll the Service ports of this Service into the peer Service.
+	// The peer Service is often considered the primary Service generated for a chia resource, as it is the most likely Service to expose publicly.
+	// This option is default, and only provides its functionality on chia-healthcheck Services. It may be included to other Services someday if a use case arises.
+	// +optional
+	RollIntoPeerService *bool `json:"rollIntoPeerService,omitempty"`
+}
+
+// PodDisruptionBudgetConfig contains kubernetes PodDisruptionBudget related configuration options
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PodDisruptionBudgetConfig struct {
+	// Enabled is a boolean selector for a PodDisruptionBudget if it should be generated.
+	// Defaults to disabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number or percentage of pods that must remain available during a voluntary disruption, such as a node drain.
+	// Mutually exclusive with maxUnavailable.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of pods that can be unavailable during a voluntary disruption, such as a node drain.
+	// Mutually exclusive with minAvailable. Defaults to 1 if neither is set.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AutoscalingConfig contains kubernetes HorizontalPodAutoscaler related configuration options
+type AutoscalingConfig struct {
+	// Enabled is a boolean selector for a HorizontalPodAutoscaler if it should be generated.
+	// The HorizontalPodAutoscaler sets the number of replicas instead of the replicas field while it's enabled.
+	// Defaults to disabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinReplicas is the lower limit for the number of replicas the HorizontalPodAutoscaler can scale down to. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas the HorizontalPodAutoscaler can scale up to. Required if autoscaling is enabled.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+
+	// Metrics contains the specifications used to calculate the desired replica count.
+	// Defaults to 80% average CPU utilization.
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior of the HorizontalPodAutoscaler in both up and down directions
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// StorageConfig contains storage configuration settings
+type StorageConfig struct {
+	// ChiaRoot is the volume CHIA_ROOT is stored on. Defaults to an emptyDir.
+	// +optional
+	ChiaRoot *VolumeConfig `json:"chiaRoot,omitempty"`
+
+	// Plots are the volumes harvester plots are mounted from. Only used by ChiaHarvesters.
+	// +optional
+	Plots *PlotsConfig `json:"plots,omitempty"`
+
+	// DataLayerServerFiles is the volume data_layer server files are stored on. Only used by ChiaDataLayers. Defaults to an emptyDir.
+	// +optional
+	DataLayerServerFiles *VolumeConfig `json:"dataLayerServerFiles,omitempty"`
+}
+
+// VolumeConfig configures the volume a directory is stored on. Only one of its sources may be set.
+// +kubebuilder:validation:MaxProperties=1
+type VolumeConfig struct {
+	// ExistingClaim mounts an existing PersistentVolumeClaim.
+	// Not supported for a ChiaNode's CHIA_ROOT, since each of its replicas needs its own claim.
+	// +optional
+	ExistingClaim *ExistingClaimVolume `json:"existingClaim,omitempty"`
+
+	// GeneratedClaim has the operator generate a PersistentVolumeClaim.
+	// A ChiaNode's CHIA_ROOT claims are generated from a volume claim template on its StatefulSet.
+	// +optional
+	GeneratedClaim *GeneratedClaimVolume `json:"generatedClaim,omitempty"`
+
+	// HostPath mounts a directory on the host.
+	// If a HostPath is used, it is highly recommended that a NodeSelector is used to keep the Pod on the host that has the directory to mount.
+	// +optional
+	HostPath *HostPathVolume `json:"hostPath,omitempty"`
+}
+
+// PlotsConfig configures the volumes harvester plots are mounted from
+type PlotsConfig struct {
+	// ExistingClaims are existing PersistentVolumeClaims to mount plot directories from
+	// +optional
+	ExistingClaims []ExistingClaimVolume `json:"existingClaims,omitempty"`
+
+	// HostPaths are directories on the host to mount plot directories from
+	// +optional
+	HostPaths []HostPathVolume `json:"hostPaths,omitempty"`
+}
+
+// ExistingClaimVolume references an existing PersistentVolumeClaim
+type ExistingClaimVolume struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the resource
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+}
+
+// GeneratedClaimVolume configures a PersistentVolumeClaim generated by the operator
+type GeneratedClaimVolume struct {
+	// StorageClass is the name of a storage class for the PVC
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// AccessModes are the volume access modes. Defaults to ReadWriteOnce.
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+
+	// Size is the amount of storage requested
+	Size resource.Quantity `json:"size"`
+}
+
+// HostPathVolume references a directory on the host
+type HostPathVolume struct {
+	// Path is the path of the directory on the host
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// Peer config for a peer - host and port
+type Peer struct {
+	// Host is the IP address or hostname to a full_node peer.
+	Host string `json:"host"`
+
+	// Port is the port number the full_node's peer port is listening on.
+	Port uint16 `json:"port"`
+}
+
+// SecretRepairPolicy describes what the operator does when a Secret it generated is found to be missing data or invalid
+// +kubebuilder:validation:Enum=None;Regenerate
+type SecretRepairPolicy string
+
+const (
+	// SecretRepairPolicyNone only reports problems found in a Secret with a Degraded condition
+	SecretRepairPolicyNone SecretRepairPolicy = "None"
+
+	// SecretRepairPolicyRegenerate regenerates the invalid contents of a Secret
+	SecretRepairPolicyRegenerate SecretRepairPolicy = "Regenerate"
+)
+
+const (
+	// ConditionTypeReady indicates that a resource's subresources are reconciled and available
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeProgressing indicates that a resource is working towards its desired state
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeDegraded indicates that a resource is running, but something it manages is in an unhealthy state
+	ConditionTypeDegraded = "Degraded"
+)
+
+// WorkloadStatus reports the rollout of the Deployment or StatefulSet that runs a Chia component
+type WorkloadStatus struct {
+	// Replicas is the number of pods the workload is running
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of the workload's pods that are ready
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of the workload's pods that have been ready for at least their minReadySeconds
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// UpdatedReplicas is the number of the workload's pods that are running its latest pod template
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Images are the chia container images currently running in the workload's pods. There is more than one during a rollout.
+	// +optional
+	Images []string `json:"images,omitempty"`
+
+	// FailingPods lists the workload's pods that can't start or keep running
+	// +optional
+	FailingPods []FailingPod `json:"failingPods,omitempty"`
+
+	// Recreation reports the workload being recreated, because its selector changed and can't be updated in place
+	// +optional
+	Recreation *WorkloadRecreation `json:"recreation,omitempty"`
+}
+
+// WorkloadRecreationPhase is a step of recreating a workload
+// +kubebuilder:validation:Enum=Deleting;Adopting
+type WorkloadRecreationPhase string
+
+const (
+	// WorkloadRecreationDeleting means the old workload is being deleted, with the objects it owns orphaned so its pods keep running
+	WorkloadRecreationDeleting WorkloadRecreationPhase = "Deleting"
+
+	// WorkloadRecreationAdopting means the old workload is gone, and the objects it orphaned are being relabeled for the new workload to adopt
+	WorkloadRecreationAdopting WorkloadRecreationPhase = "Adopting"
+)
+
+// WorkloadRecreation reports the recreation of a Deployment or StatefulSet whose selector changed.
+// The old workload is deleted without its pods, which are adopted by the new workload, so they keep running through the recreation.
+type WorkloadRecreation struct {
+	// Phase is the step the recreation is on
+	Phase WorkloadRecreationPhase `json:"phase"`
+
+	// Selector is the label selector of the old workload, which the objects it orphaned are found with
+	// +optional
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// StartTime is when the recreation started
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// FailingPod describes a pod that can't start or keep running
+type FailingPod struct {
+	// Name is the name of the pod
+	Name string `json:"name"`
+
+	// Reason is a brief CamelCase reason for the failure, such as CrashLoopBackOff or Unschedulable
+	Reason string `json:"reason"`
+
+	// Message is a human readable description of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CrawlerPeerStatus reports the peers a crawler has found on the network, from its RPC
+type CrawlerPeerStatus struct {
+	// Total is the number of peers the crawler has seen in the last 5 days
+	// +optional
+	Total int32 `json:"total,omitempty"`
+
+	// Reliable is the number of peers the crawler considers reliable
+	// +optional
+	Reliable int32 `json:"reliable,omitempty"`
+
+	// IPv4 is the number of peers with an IPv4 address the crawler has seen in the last 5 days
+	// +optional
+	IPv4 int32 `json:"ipv4,omitempty"`
+
+	// IPv6 is the number of peers with an IPv6 address the crawler has seen in the last 5 days
+	// +optional
+	IPv6 int32 `json:"ipv6,omitempty"`
+
+	// SeenLastHour is the number of peers the crawler has seen in the last hour
+	// +optional
+	SeenLastHour int32 `json:"seenLastHour,omitempty"`
+
+	// Versions lists the number of peers running each Chia version, sorted by version
+	// +optional
+	Versions []CrawlerPeerVersion `json:"versions,omitempty"`
+
+	// Error is set if the crawler RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CrawlerPeerVersion is the number of peers running a Chia version
+type CrawlerPeerVersion struct {
+	// Version is the Chia version the peers reported
+	Version string `json:"version"`
+
+	// Count is the number of peers running the version
+	Count int32 `json:"count"`
+}
+
+// CertificateBackend is the system that issues the certificates for ChiaCA and ChiaCertificates resources
+// +kubebuilder:validation:Enum=Operator;CertManager
+type CertificateBackend string
+
+const (
+	// CertificateBackendOperator has the operator generate certificates itself
+	CertificateBackendOperator CertificateBackend = "Operator"
+
+	// CertificateBackendCertManager has the operator create cert-manager Issuers and Certificates, and assemble the Secrets cert-manager issues
+	CertificateBackendCertManager CertificateBackend = "CertManager"
+)
+
+// CertManagerIssuerRef references a cert-manager Issuer or ClusterIssuer
+type CertManagerIssuerRef struct {
+	// Name is the name of the Issuer or ClusterIssuer
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer, either Issuer or ClusterIssuer. Defaults to Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group is the API group of the issuer. Defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaCrawler to the Hub version (v1)
+func (src *ChiaCrawler) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaCrawler)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecTo(&src.Spec.CommonSpec, &dst.Spec.CommonSpec)
+	convertCommonSpecChiaTo(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretName = secretRefName(src.Spec.ChiaConfig.CASecretRef)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaCrawler
+func (dst *ChiaCrawler) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaCrawler)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecFrom(&src.Spec.CommonSpec, &dst.Spec.CommonSpec, false)
+	convertCommonSpecChiaFrom(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretRef = secretRef(src.Spec.ChiaConfig.CASecretName)
+	return nil
+}
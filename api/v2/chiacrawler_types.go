@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaCrawlerSpec defines the desired state of ChiaCrawler
+type ChiaCrawlerSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaCrawlerSpecChia `json:"chia"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// Replicas is the desired number of replicas of the given Deployment. defaults to 1.
+	// More than one replica can't share a persistentVolumeClaim for CHIA_ROOT unless the claim is ReadWriteMany.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Autoscaling defines settings for an optional HorizontalPodAutoscaler, which scales the Deployment between a minimum and maximum number of replicas.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+}
+
+// ChiaCrawlerSpecChia defines the desired state of Chia component configuration
+type ChiaCrawlerSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
+}
+
+// ChiaCrawlerStatus defines the observed state of ChiaCrawler
+type ChiaCrawlerStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Peers reports the peers the crawler has found on the network, from its RPC
+	// +optional
+	Peers *CrawlerPeerStatus `json:"peers,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Peers",type="integer",JSONPath=".status.peers.total"
+// +kubebuilder:printcolumn:name="Reliable",type="integer",JSONPath=".status.peers.reliable"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChiaCrawler is the Schema for the chiacrawlers API
+type ChiaCrawler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaCrawlerSpec   `json:"spec,omitempty"`
+	Status ChiaCrawlerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChiaCrawlerList contains a list of ChiaCrawler
+type ChiaCrawlerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaCrawler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaCrawler{}, &ChiaCrawlerList{})
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaFarmer to the Hub version (v1)
+func (src *ChiaFarmer) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaFarmer)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecTo(&src.Spec.CommonSpec, &dst.Spec.CommonSpec)
+	convertCommonSpecChiaTo(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretName = src.Spec.ChiaConfig.CASecretRef.Name
+	dst.Spec.ChiaConfig.SecretKey = k8schianetv1.ChiaSecretKey(src.Spec.ChiaConfig.SecretKeyRef)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaFarmer
+func (dst *ChiaFarmer) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaFarmer)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecFrom(&src.Spec.CommonSpec, &dst.Spec.CommonSpec, false)
+	convertCommonSpecChiaFrom(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretRef = SecretReference{Name: src.Spec.ChiaConfig.CASecretName}
+	dst.Spec.ChiaConfig.SecretKeyRef = SecretKeyReference(src.Spec.ChiaConfig.SecretKey)
+	dst.Spec.ChiaConfig.FullNodePeers = fullNodePeersFrom(src.Spec.ChiaConfig.FullNodePeer, src.Spec.ChiaConfig.FullNodePeers)
+	return nil
+}
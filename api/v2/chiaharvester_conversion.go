@@ -0,0 +1,35 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaHarvester to the Hub version (v1)
+func (src *ChiaHarvester) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaHarvester)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecTo(&src.Spec.CommonSpec, &dst.Spec.CommonSpec)
+	convertCommonSpecChiaTo(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretName = src.Spec.ChiaConfig.CASecretRef.Name
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaHarvester
+func (dst *ChiaHarvester) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaHarvester)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecFrom(&src.Spec.CommonSpec, &dst.Spec.CommonSpec, false)
+	convertCommonSpecChiaFrom(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.CASecretRef = SecretReference{Name: src.Spec.ChiaConfig.CASecretName}
+	return nil
+}
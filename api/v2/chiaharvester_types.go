@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaHarvesterSpec defines the desired state of ChiaHarvester
+type ChiaHarvesterSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaHarvesterSpecChia `json:"chia"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+}
+
+// ChiaHarvesterSpecChia defines the desired state of Chia component configuration
+type ChiaHarvesterSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	CASecretRef SecretReference `json:"caSecretRef"`
+
+	// FarmerAddress defines the harvester's farmer peer's hostname. The farmer's port is inferred.
+	// In Kubernetes this is likely to be <farmer service name>.<namespace>.svc.cluster.local
+	FarmerAddress string `json:"farmerAddress"`
+}
+
+// ChiaHarvesterStatus defines the observed state of ChiaHarvester
+type ChiaHarvesterStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Plots reports the plots the harvester has loaded, from its RPC
+	// +optional
+	Plots *ChiaHarvesterPlotsStatus `json:"plots,omitempty"`
+}
+
+// ChiaHarvesterPlotsStatus reports the plots a harvester has loaded, from its RPC get_plots endpoint
+type ChiaHarvesterPlotsStatus struct {
+	// PlotCount is the number of plots the harvester has loaded
+	// +optional
+	PlotCount int32 `json:"plotCount,omitempty"`
+
+	// FailedPlots is the number of plot files the harvester failed to open
+	// +optional
+	FailedPlots int32 `json:"failedPlots,omitempty"`
+
+	// NotFoundPlots is the number of plot files the harvester expected, but couldn't find
+	// +optional
+	NotFoundPlots int32 `json:"notFoundPlots,omitempty"`
+
+	// RawSpace is the total size of the harvester's plot files
+	// +optional
+	RawSpace *resource.Quantity `json:"rawSpace,omitempty"`
+
+	// EffectiveSpace is the space the harvester's plots are worth in the farming lottery
+	// +optional
+	EffectiveSpace *resource.Quantity `json:"effectiveSpace,omitempty"`
+
+	// Error is set if the harvester RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Plots",type="integer",JSONPath=".status.plots.plotCount"
+//+kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.plots.failedPlots"
+//+kubebuilder:printcolumn:name="Effective Space",type="string",JSONPath=".status.plots.effectiveSpace"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChiaHarvester is the Schema for the chiaharvesters API
+type ChiaHarvester struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaHarvesterSpec   `json:"spec,omitempty"`
+	Status ChiaHarvesterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaHarvesterList contains a list of ChiaHarvester
+type ChiaHarvesterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaHarvester `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaHarvester{}, &ChiaHarvesterList{})
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ChiaIntroducerSpec defines the desired state of ChiaIntroducer
+type ChiaIntroducerSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaIntroducerSpecChia `json:"chia"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// Replicas is the desired number of replicas of the given Deployment. defaults to 1.
+	// More than one replica can't share a persistentVolumeClaim for CHIA_ROOT unless the claim is ReadWriteMany.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Autoscaling defines settings for an optional HorizontalPodAutoscaler, which scales the Deployment between a minimum and maximum number of replicas.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+}
+
+// ChiaIntroducerSpecChia defines the desired state of Chia component configuration
+type ChiaIntroducerSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
+}
+
+// ChiaIntroducerStatus defines the observed state of ChiaIntroducer
+type ChiaIntroducerStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+
+// ChiaIntroducer is the Schema for the chiaintroducers API
+type ChiaIntroducer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaIntroducerSpec   `json:"spec,omitempty"`
+	Status ChiaIntroducerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChiaIntroducerList contains a list of ChiaIntroducer
+type ChiaIntroducerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaIntroducer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaIntroducer{}, &ChiaIntroducerList{})
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaKey to the Hub version (v1)
+func (src *ChiaKey) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaKey)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaKey
+func (dst *ChiaKey) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaKey)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	return nil
+}
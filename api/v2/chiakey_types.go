@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaKeySpec defines the desired state of ChiaKey
+type ChiaKeySpec struct {
+	// Secret defines the name of the Secret to contain the generated mnemonic. Defaults to the name of the ChiaKey resource.
+	// +optional
+	Secret string `json:"secret,omitempty"`
+
+	// Key is the key of the data item in the Secret that contains the mnemonic, used as the key in a SecretKeyReference. Defaults to key.txt
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// ChiaKeyStatus defines the observed state of ChiaKey
+type ChiaKeyStatus struct {
+	// Ready says whether the key is ready, this should be true when the mnemonic Secret is in the target namespace
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// Fingerprint is the fingerprint of the key, as shown by `chia keys show`
+	// +optional
+	Fingerprint int64 `json:"fingerprint,omitempty"`
+
+	// MasterPublicKey is the hex encoded master public key
+	// +optional
+	MasterPublicKey string `json:"masterPublicKey,omitempty"`
+
+	// FarmerPublicKey is the hex encoded farmer public key
+	// +optional
+	FarmerPublicKey string `json:"farmerPublicKey,omitempty"`
+
+	// PoolPublicKey is the hex encoded pool public key
+	// +optional
+	PoolPublicKey string `json:"poolPublicKey,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+
+// ChiaKey is the Schema for the chiakeys API
+type ChiaKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaKeySpec   `json:"spec,omitempty"`
+	Status ChiaKeyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaKeyList contains a list of ChiaKey
+type ChiaKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaKey{}, &ChiaKeyList{})
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaNodeSpec defines the desired state of ChiaNode
+// +kubebuilder:validation:XValidation:rule="!has(self.storage) || !has(self.storage.chiaRoot) || !has(self.storage.chiaRoot.existingClaim)",message="storage.chiaRoot.existingClaim is not supported for ChiaNodes, use generatedClaim"
+type ChiaNodeSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaNodeSpecChia `json:"chia"`
+
+	// ChiaHealthcheckConfig defines the configuration options available to an optional Chia healthcheck sidecar
+	// +optional
+	ChiaHealthcheckConfig SpecChiaHealthcheck `json:"chiaHealthcheck,omitempty"`
+
+	// Replicas is the desired number of replicas of the given Statefulset. defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// UpdateStrategy indicates the strategy that the StatefulSet controller will use to perform updates.
+	// +optional
+	UpdateStrategy *appsv1.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+}
+
+// ChiaNodeSpecChia defines the desired state of Chia component configuration
+type ChiaNodeSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	CASecretRef SecretReference `json:"caSecretRef"`
+
+	// TrustedCIDRs is a list of CIDRs that this chia component should trust peers from
+	// See: https://docs.chia.net/faq/?_highlight=trust#what-are-trusted-peers-and-how-do-i-add-them
+	// +optional
+	TrustedCIDRs []string `json:"trustedCIDRs,omitempty"`
+
+	// FullNodePeers is a list of hostnames/IPs and port numbers to full_node peers.
+	// +optional
+	FullNodePeers []Peer `json:"fullNodePeers,omitempty"`
+}
+
+// ChiaNodeStatus defines the observed state of ChiaNode
+type ChiaNodeStatus struct {
+	// Ready says whether the node is ready, this is true when its StatefulSet has rolled out and all of its replicas are ready
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's StatefulSet
+	WorkloadStatus `json:",inline"`
+
+	// FullNodes reports the blockchain state of each of the node's running pods, from their full_node RPC
+	// +optional
+	// +listType=map
+	// +listMapKey=pod
+	FullNodes []ChiaNodeFullNodeStatus `json:"fullNodes,omitempty"`
+}
+
+// ChiaNodeFullNodeStatus is the blockchain state of one ChiaNode pod, from its full_node RPC get_blockchain_state and get_connections endpoints
+type ChiaNodeFullNodeStatus struct {
+	// Pod is the name of the pod
+	Pod string `json:"pod"`
+
+	// PeakHeight is the height of the full_node's peak block
+	// +optional
+	PeakHeight int64 `json:"peakHeight,omitempty"`
+
+	// Synced says whether the full_node has synced to the peak of its peers
+	// +optional
+	Synced bool `json:"synced,omitempty"`
+
+	// SyncMode says whether the full_node is doing a long sync
+	// +optional
+	SyncMode bool `json:"syncMode,omitempty"`
+
+	// SyncProgressHeight is the height the full_node has synced to during a long sync
+	// +optional
+	SyncProgressHeight int64 `json:"syncProgressHeight,omitempty"`
+
+	// SyncTipHeight is the height the full_node is syncing towards during a long sync
+	// +optional
+	SyncTipHeight int64 `json:"syncTipHeight,omitempty"`
+
+	// Difficulty is the current difficulty of the blockchain
+	// +optional
+	Difficulty int64 `json:"difficulty,omitempty"`
+
+	// Connections is the number of peers the full_node is connected to
+	// +optional
+	Connections int32 `json:"connections,omitempty"`
+
+	// Error is set if the full_node RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+
+// ChiaNode is the Schema for the chianodes API
+type ChiaNode struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaNodeSpec   `json:"spec,omitempty"`
+	Status ChiaNodeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaNodeList contains a list of ChiaNode
+type ChiaNodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaNode `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaNode{}, &ChiaNodeList{})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaSeederSpec defines the desired state of ChiaSeeder
+type ChiaSeederSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaSeederSpecChia `json:"chia"`
+
+	// ChiaHealthcheckConfig defines the configuration options available to an optional Chia healthcheck sidecar
+	// +optional
+	ChiaHealthcheckConfig SpecChiaHealthcheck `json:"chiaHealthcheck,omitempty"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// Replicas is the desired number of replicas of the given Deployment. defaults to 1.
+	// More than one replica can't share a persistentVolumeClaim for CHIA_ROOT unless the claim is ReadWriteMany.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Autoscaling defines settings for an optional HorizontalPodAutoscaler, which scales the Deployment between a minimum and maximum number of replicas.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget defines settings for an optional PodDisruptionBudget, which limits how many of this resource's pods a voluntary disruption such as a node drain can evict at once.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+}
+
+// ChiaSeederSpecChia defines the desired state of Chia component configuration
+type ChiaSeederSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// BootstrapPeers a list of peers to bootstrap the seeder's peer database
+	// +optional
+	BootstrapPeers []string `json:"bootstrapPeers,omitempty"`
+
+	// MinimumHeight only consider nodes synced at least to this height
+	// +optional
+	MinimumHeight *uint64 `json:"minimumHeight,omitempty"`
+
+	// DomainName the name of the NS record for your server with a trailing period. (ex. "seeder.example.com.")
+	DomainName string `json:"domainName"`
+
+	// Nameserver the name of the A record for your server with a trailing period. (ex. "seeder-us-west-2.example.com.")
+	Nameserver string `json:"nameserver"`
+
+	// Rname an administrator's email address with '@' replaced with '.'
+	Rname string `json:"rname"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
+
+	// TTL field on DNS records that controls the length of time that a record is considered valid
+	// +optional
+	TTL *uint32 `json:"ttl,omitempty"`
+}
+
+// ChiaSeederStatus defines the observed state of ChiaSeeder
+type ChiaSeederStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Peers reports the peers the crawler has found on the network, from its RPC
+	// +optional
+	Peers *CrawlerPeerStatus `json:"peers,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Peers",type="integer",JSONPath=".status.peers.total"
+//+kubebuilder:printcolumn:name="Reliable",type="integer",JSONPath=".status.peers.reliable"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChiaSeeder is the Schema for the chiaseeders API
+type ChiaSeeder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaSeederSpec   `json:"spec,omitempty"`
+	Status ChiaSeederStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaSeederList contains a list of ChiaSeeder
+type ChiaSeederList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaSeeder `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaSeeder{}, &ChiaSeederList{})
+}
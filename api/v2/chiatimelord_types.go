@@ -0,0 +1,84 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaTimelordSpec defines the desired state of ChiaTimelord
+type ChiaTimelordSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaTimelordSpecChia `json:"chia"`
+
+	// ChiaHealthcheckConfig defines the configuration options available to an optional Chia healthcheck sidecar
+	// +optional
+	ChiaHealthcheckConfig SpecChiaHealthcheck `json:"chiaHealthcheck,omitempty"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+}
+
+// ChiaTimelordSpecChia defines the desired state of Chia component configuration
+type ChiaTimelordSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	CASecretRef SecretReference `json:"caSecretRef"`
+
+	// FullNodePeers is a list of hostnames/IPs and port numbers to full_node peers.
+	// +optional
+	FullNodePeers []Peer `json:"fullNodePeers,omitempty"`
+}
+
+// ChiaTimelordStatus defines the observed state of ChiaTimelord
+type ChiaTimelordStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+
+// ChiaTimelord is the Schema for the chiatimelords API
+type ChiaTimelord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaTimelordSpec   `json:"spec,omitempty"`
+	Status ChiaTimelordStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaTimelordList contains a list of ChiaTimelord
+type ChiaTimelordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaTimelord `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaTimelord{}, &ChiaTimelordList{})
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// ConvertTo converts this ChiaWallet to the Hub version (v1)
+func (src *ChiaWallet) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*k8schianetv1.ChiaWallet)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecTo(&src.Spec.CommonSpec, &dst.Spec.CommonSpec)
+	convertCommonSpecChiaTo(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.SecretKey = k8schianetv1.ChiaSecretKey(src.Spec.ChiaConfig.SecretKeyRef)
+	dst.Spec.ChiaConfig.CASecretName = secretRefName(src.Spec.ChiaConfig.CASecretRef)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this ChiaWallet
+func (dst *ChiaWallet) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*k8schianetv1.ChiaWallet)
+	if err := convertJSON(src, dst); err != nil {
+		return err
+	}
+	convertCommonSpecFrom(&src.Spec.CommonSpec, &dst.Spec.CommonSpec, false)
+	convertCommonSpecChiaFrom(&src.Spec.ChiaConfig.CommonSpecChia, &dst.Spec.ChiaConfig.CommonSpecChia)
+	dst.Spec.ChiaConfig.SecretKeyRef = SecretKeyReference(src.Spec.ChiaConfig.SecretKey)
+	dst.Spec.ChiaConfig.CASecretRef = secretRef(src.Spec.ChiaConfig.CASecretName)
+	dst.Spec.ChiaConfig.FullNodePeers = fullNodePeersFrom(src.Spec.ChiaConfig.FullNodePeer, src.Spec.ChiaConfig.FullNodePeers)
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChiaWalletSpec defines the desired state of ChiaWallet
+type ChiaWalletSpec struct {
+	CommonSpec `json:",inline"`
+
+	// ChiaConfig defines the configuration options available to Chia component containers
+	ChiaConfig ChiaWalletSpecChia `json:"chia"`
+
+	// Strategy describes how to replace existing pods with new ones.
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// ReportBalance can be set to true to report the balance of the wallet's standard XCH wallet in its status.
+	// Anyone who can read the ChiaWallet can see the balance, so it's disabled by default.
+	// +optional
+	ReportBalance *bool `json:"reportBalance,omitempty"`
+}
+
+// ChiaWalletSpecChia defines the desired state of Chia component configuration
+type ChiaWalletSpecChia struct {
+	CommonSpecChia `json:",inline"`
+
+	// SecretKeyRef references the Secret data item that contains the Chia mnemonic
+	SecretKeyRef SecretKeyReference `json:"secretKeyRef"`
+
+	// CASecretRef references the Secret that contains the CA crt and key
+	// +optional
+	CASecretRef *SecretReference `json:"caSecretRef,omitempty"`
+
+	// FullNodePeers is a list of hostnames/IPs and port numbers to full_node peers.
+	// +optional
+	FullNodePeers []Peer `json:"fullNodePeers,omitempty"`
+
+	// TrustedCIDRs is a list of CIDRs that this chia component should trust peers from
+	// See: https://docs.chia.net/faq/?_highlight=trust#what-are-trusted-peers-and-how-do-i-add-them
+	// +optional
+	TrustedCIDRs []string `json:"trustedCIDRs,omitempty"`
+
+	// XCHSpamAmount any standard TX under xch_spam_amount is filtered
+	// +optional
+	XCHSpamAmount *uint64 `json:"xchSpamAmount,omitempty"`
+}
+
+// ChiaWalletStatus defines the observed state of ChiaWallet
+type ChiaWalletStatus struct {
+	// Ready says whether the chia component is ready, this is true when its Deployment has rolled out and all of its replicas are available
+	// +kubebuilder:default=false
+	Ready bool `json:"ready,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the resource observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the resource's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// WorkloadStatus reports the rollout of the resource's Deployment
+	WorkloadStatus `json:",inline"`
+
+	// Wallet reports the wallet's sync state, from its RPC
+	// +optional
+	Wallet *ChiaWalletSyncStatus `json:"wallet,omitempty"`
+}
+
+// ChiaWalletSyncStatus reports a wallet's sync state, from its RPC
+type ChiaWalletSyncStatus struct {
+	// Synced says whether the wallet has synced to the peak of its full_node peers
+	// +optional
+	Synced bool `json:"synced,omitempty"`
+
+	// Syncing says whether the wallet is syncing
+	// +optional
+	Syncing bool `json:"syncing,omitempty"`
+
+	// Height is the height the wallet has synced to
+	// +optional
+	Height int64 `json:"height,omitempty"`
+
+	// Fingerprint is the fingerprint of the key logged in to the wallet
+	// +optional
+	Fingerprint int64 `json:"fingerprint,omitempty"`
+
+	// FullNodePeers lists the full_node peers the wallet is connected to, in host:port format
+	// +optional
+	FullNodePeers []string `json:"fullNodePeers,omitempty"`
+
+	// ConfirmedBalance is the confirmed balance of the standard XCH wallet in mojos. Only reported if reportBalance is true.
+	// +optional
+	ConfirmedBalance *int64 `json:"confirmedBalance,omitempty"`
+
+	// SpendableBalance is the spendable balance of the standard XCH wallet in mojos. Only reported if reportBalance is true.
+	// +optional
+	SpendableBalance *int64 `json:"spendableBalance,omitempty"`
+
+	// Error is set if the wallet RPC couldn't be queried
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:unservedversion
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Synced",type="boolean",JSONPath=".status.wallet.synced"
+//+kubebuilder:printcolumn:name="Height",type="integer",JSONPath=".status.wallet.height"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ChiaWallet is the Schema for the chiawallets API
+type ChiaWallet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChiaWalletSpec   `json:"spec,omitempty"`
+	Status ChiaWalletStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChiaWalletList contains a list of ChiaWallet
+type ChiaWalletList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChiaWallet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChiaWallet{}, &ChiaWalletList{})
+}
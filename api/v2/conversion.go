@@ -0,0 +1,214 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// convertJSON copies an object into another version of its kind through its JSON encoding, which carries over every field that has the same name and schema in both versions.
+// dst keeps its own apiVersion and kind. Fields that differ between the versions are ignored, and must be converted separately.
+func convertJSON(src, dst runtime.Object) error {
+	gvk := dst.GetObjectKind().GroupVersionKind()
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return err
+	}
+	dst.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+// convertCommonSpecTo converts the fields of a v2 CommonSpec that differ from v1
+func convertCommonSpecTo(src *CommonSpec, dst *k8schianetv1.CommonSpec) {
+	dst.Storage = convertStorageTo(src.Storage)
+	dst.ChiaExporterConfig.ConfigSecretName = secretRefName(src.ChiaExporterConfig.ConfigSecretRef)
+}
+
+// convertCommonSpecFrom converts the fields of a v1 CommonSpec that differ from v2.
+// ChiaNodes generate their CHIA_ROOT claims from any persistentVolumeClaim, so they're converted with node set to true.
+func convertCommonSpecFrom(src *k8schianetv1.CommonSpec, dst *CommonSpec, node bool) {
+	dst.Storage = convertStorageFrom(src.Storage, node)
+	dst.ChiaExporterConfig.ConfigSecretRef = secretRef(src.ChiaExporterConfig.ConfigSecretName)
+}
+
+// convertCommonSpecChiaTo converts the fields of a v2 CommonSpecChia that differ from v1
+func convertCommonSpecChiaTo(src *CommonSpecChia, dst *k8schianetv1.CommonSpecChia) {
+	dst.CertificatesSecretName = secretRefName(src.CertificatesSecretRef)
+}
+
+// convertCommonSpecChiaFrom converts the fields of a v1 CommonSpecChia that differ from v2
+func convertCommonSpecChiaFrom(src *k8schianetv1.CommonSpecChia, dst *CommonSpecChia) {
+	dst.CertificatesSecretRef = secretRef(src.CertificatesSecretName)
+}
+
+// secretRef returns a reference to the named Secret, or nil if no name is given
+func secretRef(name *string) *SecretReference {
+	if name == nil {
+		return nil
+	}
+	return &SecretReference{Name: *name}
+}
+
+// secretRefName returns the name of the referenced Secret, or nil if there's no reference
+func secretRefName(ref *SecretReference) *string {
+	if ref == nil {
+		return nil
+	}
+	name := ref.Name
+	return &name
+}
+
+// fullNodePeersFrom returns a v1 resource's full_node peers as a list.
+// The deprecated fullNodePeer field is only used by v1 when fullNodePeers is unset, so it's only converted in that case.
+func fullNodePeersFrom(fullNodePeer *string, fullNodePeers *[]k8schianetv1.Peer) []Peer {
+	if fullNodePeers != nil || fullNodePeer == nil {
+		return convertPeersFrom(fullNodePeers)
+	}
+
+	// An address that can't be parsed is carried over as the host, so it's still visible on the resource
+	peer := Peer{Host: *fullNodePeer}
+	host, port, err := net.SplitHostPort(*fullNodePeer)
+	if err == nil {
+		if p, err := strconv.ParseUint(port, 10, 16); err == nil {
+			peer = Peer{Host: host, Port: uint16(p)}
+		}
+	}
+	return []Peer{peer}
+}
+
+// convertPeersFrom converts a v1 list of peers
+func convertPeersFrom(peers *[]k8schianetv1.Peer) []Peer {
+	if peers == nil {
+		return nil
+	}
+	converted := make([]Peer, 0, len(*peers))
+	for _, peer := range *peers {
+		converted = append(converted, Peer(peer))
+	}
+	return converted
+}
+
+// convertStorageTo converts a v2 StorageConfig to v1
+func convertStorageTo(src *StorageConfig) *k8schianetv1.StorageConfig {
+	if src == nil {
+		return nil
+	}
+
+	dst := &k8schianetv1.StorageConfig{}
+	if src.ChiaRoot != nil {
+		pvc, hostPath := convertVolumeTo(src.ChiaRoot)
+		dst.ChiaRoot = &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: pvc,
+			HostPathVolume:        hostPath,
+		}
+	}
+	if src.DataLayerServerFiles != nil {
+		pvc, hostPath := convertVolumeTo(src.DataLayerServerFiles)
+		dst.DataLayerServerFiles = &k8schianetv1.DataLayerServerFilesConfig{
+			PersistentVolumeClaim: pvc,
+			HostPathVolume:        hostPath,
+		}
+	}
+	if src.Plots != nil {
+		dst.Plots = &k8schianetv1.PlotsConfig{}
+		for _, claim := range src.Plots.ExistingClaims {
+			dst.Plots.PersistentVolumeClaim = append(dst.Plots.PersistentVolumeClaim, &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: claim.ClaimName})
+		}
+		for _, hostPath := range src.Plots.HostPaths {
+			dst.Plots.HostPathVolume = append(dst.Plots.HostPathVolume, &k8schianetv1.HostPathVolumeConfig{Path: hostPath.Path})
+		}
+	}
+	return dst
+}
+
+// convertVolumeTo converts a v2 VolumeConfig to the v1 persistentVolumeClaim and hostPathVolume configs
+func convertVolumeTo(src *VolumeConfig) (*k8schianetv1.PersistentVolumeClaimConfig, *k8schianetv1.HostPathVolumeConfig) {
+	switch {
+	case src.ExistingClaim != nil:
+		return &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: src.ExistingClaim.ClaimName}, nil
+	case src.GeneratedClaim != nil:
+		return &k8schianetv1.PersistentVolumeClaimConfig{
+			GenerateVolumeClaims: true,
+			StorageClass:         src.GeneratedClaim.StorageClass,
+			AccessModes:          src.GeneratedClaim.AccessModes,
+			ResourceRequest:      src.GeneratedClaim.Size.String(),
+		}, nil
+	case src.HostPath != nil:
+		return nil, &k8schianetv1.HostPathVolumeConfig{Path: src.HostPath.Path}
+	}
+	return nil, nil
+}
+
+// convertStorageFrom converts a v1 StorageConfig to v2
+func convertStorageFrom(src *k8schianetv1.StorageConfig, node bool) *StorageConfig {
+	if src == nil {
+		return nil
+	}
+
+	dst := &StorageConfig{}
+	if src.ChiaRoot != nil {
+		dst.ChiaRoot = convertVolumeFrom(src.ChiaRoot.PersistentVolumeClaim, src.ChiaRoot.HostPathVolume, node)
+	}
+	if src.DataLayerServerFiles != nil {
+		dst.DataLayerServerFiles = convertVolumeFrom(src.DataLayerServerFiles.PersistentVolumeClaim, src.DataLayerServerFiles.HostPathVolume, false)
+	}
+	if src.Plots != nil {
+		dst.Plots = &PlotsConfig{}
+		for _, claim := range src.Plots.PersistentVolumeClaim {
+			if claim != nil {
+				dst.Plots.ExistingClaims = append(dst.Plots.ExistingClaims, ExistingClaimVolume{ClaimName: claim.ClaimName})
+			}
+		}
+		for _, hostPath := range src.Plots.HostPathVolume {
+			if hostPath != nil {
+				dst.Plots.HostPaths = append(dst.Plots.HostPaths, HostPathVolume{Path: hostPath.Path})
+			}
+		}
+	}
+	return dst
+}
+
+// convertVolumeFrom converts v1 persistentVolumeClaim and hostPathVolume configs to a v2 VolumeConfig, picking the volume the v1 controllers would use.
+// An empty VolumeConfig is returned when the v1 controllers would fall back to an emptyDir.
+func convertVolumeFrom(pvc *k8schianetv1.PersistentVolumeClaimConfig, hostPath *k8schianetv1.HostPathVolumeConfig, node bool) *VolumeConfig {
+	if node && pvc != nil {
+		// ChiaNodes ignore claimName, and only generate a claim when the resource request parses
+		size, err := resource.ParseQuantity(pvc.ResourceRequest)
+		if err != nil {
+			return &VolumeConfig{}
+		}
+		return &VolumeConfig{GeneratedClaim: &GeneratedClaimVolume{
+			StorageClass: pvc.StorageClass,
+			AccessModes:  pvc.AccessModes,
+			Size:         size,
+		}}
+	}
+
+	switch {
+	case pvc != nil && pvc.GenerateVolumeClaims:
+		// The controllers fail to generate a claim whose resource request doesn't parse, which the validating webhook rejects
+		size, _ := resource.ParseQuantity(pvc.ResourceRequest)
+		return &VolumeConfig{GeneratedClaim: &GeneratedClaimVolume{
+			StorageClass: pvc.StorageClass,
+			AccessModes:  pvc.AccessModes,
+			Size:         size,
+		}}
+	case pvc != nil && pvc.ClaimName != "":
+		return &VolumeConfig{ExistingClaim: &ExistingClaimVolume{ClaimName: pvc.ClaimName}}
+	case hostPath != nil && hostPath.Path != "":
+		return &VolumeConfig{HostPath: &HostPathVolume{Path: hostPath.Path}}
+	}
+	return &VolumeConfig{}
+}
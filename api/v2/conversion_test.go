@@ -0,0 +1,454 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/yaml"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+// roundTrip converts src to the hub version and back into dst, and fails the test if anything was lost along the way
+func roundTrip(t *testing.T, src conversion.Convertible, hub conversion.Hub, dst conversion.Convertible) {
+	t.Helper()
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatalf("Error converting to v1: %v", err)
+	}
+	if err := dst.ConvertFrom(hub); err != nil {
+		t.Fatalf("Error converting from v1: %v", err)
+	}
+
+	diff := cmp.Diff(dst, src)
+	if diff != "" {
+		t.Errorf("Round tripped object does not match the original object. Diff: %s", diff)
+	}
+}
+
+func TestConvertChiaFarmerFromV1(t *testing.T) {
+	yamlData := []byte(`
+apiVersion: k8s.chia.net/v1
+kind: ChiaFarmer
+metadata:
+  name: chiafarmer-sample
+spec:
+  chia:
+    caSecretName: chiaca-secret
+    certificatesSecretName: chiacertificates-secret
+    fullNodePeer: node.default.svc.cluster.local:58444
+    secretKey:
+      name: chiakey-secret
+      key: key.txt
+  chiaExporter:
+    configSecretName: exporter-secret
+  storage:
+    chiaRoot:
+      persistentVolumeClaim:
+        generateVolumeClaims: true
+        storageClass: fast
+        resourceRequest: 10Gi
+`)
+	var src k8schianetv1.ChiaFarmer
+	if err := yaml.Unmarshal(yamlData, &src); err != nil {
+		t.Fatalf("Error unmarshaling yaml: %v", err)
+	}
+
+	var actual ChiaFarmer
+	if err := actual.ConvertFrom(&src); err != nil {
+		t.Fatalf("Error converting from v1: %v", err)
+	}
+
+	expect := ChiaFarmer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "chiafarmer-sample",
+		},
+		Spec: ChiaFarmerSpec{
+			ChiaConfig: ChiaFarmerSpecChia{
+				CommonSpecChia: CommonSpecChia{
+					CertificatesSecretRef: &SecretReference{Name: "chiacertificates-secret"},
+				},
+				CASecretRef: SecretReference{Name: "chiaca-secret"},
+				SecretKeyRef: SecretKeyReference{
+					Name: "chiakey-secret",
+					Key:  "key.txt",
+				},
+				FullNodePeers: []Peer{
+					{Host: "node.default.svc.cluster.local", Port: 58444},
+				},
+			},
+			CommonSpec: CommonSpec{
+				ChiaExporterConfig: SpecChiaExporter{
+					ConfigSecretRef: &SecretReference{Name: "exporter-secret"},
+				},
+				Storage: &StorageConfig{
+					ChiaRoot: &VolumeConfig{
+						GeneratedClaim: &GeneratedClaimVolume{
+							StorageClass: "fast",
+							Size:         resource.MustParse("10Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diff := cmp.Diff(actual, expect)
+	if diff != "" {
+		t.Errorf("Converted struct does not match the expected struct. Actual: %+v\nExpected: %+v\nDiff: %s", actual, expect, diff)
+	}
+}
+
+func TestConvertChiaFarmerRoundTrip(t *testing.T) {
+	src := &ChiaFarmer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "chiafarmer-sample",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "farmer"},
+		},
+		Spec: ChiaFarmerSpec{
+			CommonSpec: CommonSpec{
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+				ChiaExporterConfig: SpecChiaExporter{
+					ConfigSecretRef: &SecretReference{Name: "exporter-secret"},
+				},
+				Storage: &StorageConfig{
+					ChiaRoot: &VolumeConfig{
+						HostPath: &HostPathVolume{Path: "/mnt/chiaroot"},
+					},
+				},
+				PodTemplate: &runtime.RawExtension{Raw: []byte(`{"spec":{"priorityClassName":"chia"}}`)},
+			},
+			ChiaConfig: ChiaFarmerSpecChia{
+				CommonSpecChia: CommonSpecChia{
+					CertificatesSecretRef: &SecretReference{Name: "chiacertificates-secret"},
+					AdditionalEnv:         []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+				},
+				CASecretRef: SecretReference{Name: "chiaca-secret"},
+				SecretKeyRef: SecretKeyReference{
+					Name: "chiakey-secret",
+					Key:  "key.txt",
+				},
+				FullNodePeers: []Peer{{Host: "node", Port: 8444}},
+			},
+		},
+		Status: ChiaFarmerStatus{
+			Ready:              true,
+			ObservedGeneration: 2,
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaFarmer{}, &ChiaFarmer{})
+}
+
+func TestConvertChiaNodeRoundTrip(t *testing.T) {
+	src := &ChiaNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "chianode-sample"},
+		Spec: ChiaNodeSpec{
+			CommonSpec: CommonSpec{
+				Storage: &StorageConfig{
+					ChiaRoot: &VolumeConfig{
+						GeneratedClaim: &GeneratedClaimVolume{
+							StorageClass: "fast",
+							AccessModes:  []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod},
+							Size:         resource.MustParse("300Gi"),
+						},
+					},
+				},
+			},
+			ChiaConfig: ChiaNodeSpecChia{
+				CASecretRef:  SecretReference{Name: "chiaca-secret"},
+				TrustedCIDRs: []string{"10.0.0.0/8"},
+			},
+			Replicas: 3,
+			PodDisruptionBudget: &PodDisruptionBudgetConfig{
+				Enabled:      ptr.To(true),
+				MinAvailable: ptr.To(intstr.FromInt32(2)),
+			},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaNode{}, &ChiaNode{})
+}
+
+func TestConvertChiaHarvesterRoundTrip(t *testing.T) {
+	src := &ChiaHarvester{
+		ObjectMeta: metav1.ObjectMeta{Name: "chiaharvester-sample"},
+		Spec: ChiaHarvesterSpec{
+			CommonSpec: CommonSpec{
+				Storage: &StorageConfig{
+					Plots: &PlotsConfig{
+						ExistingClaims: []ExistingClaimVolume{{ClaimName: "plots-1"}, {ClaimName: "plots-2"}},
+						HostPaths:      []HostPathVolume{{Path: "/mnt/plots"}},
+					},
+				},
+			},
+			ChiaConfig: ChiaHarvesterSpecChia{
+				CASecretRef: SecretReference{Name: "chiaca-secret"},
+			},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaHarvester{}, &ChiaHarvester{})
+}
+
+func TestConvertChiaDataLayerRoundTrip(t *testing.T) {
+	src := &ChiaDataLayer{
+		ObjectMeta: metav1.ObjectMeta{Name: "chiadatalayer-sample"},
+		Spec: ChiaDataLayerSpec{
+			CommonSpec: CommonSpec{
+				Storage: &StorageConfig{
+					ChiaRoot: &VolumeConfig{
+						ExistingClaim: &ExistingClaimVolume{ClaimName: "chiaroot"},
+					},
+					DataLayerServerFiles: &VolumeConfig{
+						GeneratedClaim: &GeneratedClaimVolume{Size: resource.MustParse("1Ti")},
+					},
+				},
+			},
+			ChiaConfig: ChiaDataLayerSpecChia{
+				CASecretRef: &SecretReference{Name: "chiaca-secret"},
+				SecretKeyRef: SecretKeyReference{
+					Name: "chiakey-secret",
+					Key:  "key.txt",
+				},
+				FullNodePeers: []Peer{{Host: "node", Port: 8444}},
+			},
+			FileserverConfig: FileserverConfig{
+				Enabled:  ptr.To(true),
+				Replicas: ptr.To(int32(2)),
+			},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaDataLayer{}, &ChiaDataLayer{})
+}
+
+func TestConvertChiaSeederRoundTrip(t *testing.T) {
+	src := &ChiaSeeder{
+		ObjectMeta: metav1.ObjectMeta{Name: "chiaseeder-sample"},
+		Spec: ChiaSeederSpec{
+			ChiaConfig: ChiaSeederSpecChia{
+				BootstrapPeers: []string{"node-1", "node-2"},
+				DomainName:     "seeder.example.com.",
+				Nameserver:     "example.com.",
+				Rname:          "admin.example.com",
+			},
+			Replicas: 1,
+			Autoscaling: &AutoscalingConfig{
+				Enabled:     ptr.To(true),
+				MinReplicas: ptr.To(int32(2)),
+				MaxReplicas: 4,
+			},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaSeeder{}, &ChiaSeeder{})
+}
+
+func TestConvertChiaWalletRoundTrip(t *testing.T) {
+	src := &ChiaWallet{
+		ObjectMeta: metav1.ObjectMeta{Name: "chiawallet-sample"},
+		Spec: ChiaWalletSpec{
+			ChiaConfig: ChiaWalletSpecChia{
+				SecretKeyRef: SecretKeyReference{
+					Name: "chiakey-secret",
+					Key:  "key.txt",
+				},
+				FullNodePeers: []Peer{{Host: "node", Port: 8444}},
+			},
+		},
+		Status: ChiaWalletStatus{
+			Wallet: &ChiaWalletSyncStatus{
+				Synced:        true,
+				FullNodePeers: []string{"node:8444"},
+			},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaWallet{}, &ChiaWallet{})
+}
+
+func TestConvertChiaCertificatesRoundTrip(t *testing.T) {
+	src := &ChiaCertificates{
+		ObjectMeta: metav1.ObjectMeta{Name: "chiacertificates-sample"},
+		Spec: ChiaCertificatesSpec{
+			Secret:      "chiacertificates-secret",
+			CASecretRef: SecretReference{Name: "chiaca-secret"},
+		},
+	}
+	roundTrip(t, src, &k8schianetv1.ChiaCertificates{}, &ChiaCertificates{})
+}
+
+func TestConvertChiaSeederBootstrapPeerFromV1(t *testing.T) {
+	src := &k8schianetv1.ChiaSeeder{
+		Spec: k8schianetv1.ChiaSeederSpec{
+			ChiaConfig: k8schianetv1.ChiaSeederSpecChia{
+				BootstrapPeer: ptr.To("node-1"),
+			},
+		},
+	}
+	var actual ChiaSeeder
+	if err := actual.ConvertFrom(src); err != nil {
+		t.Fatalf("Error converting from v1: %v", err)
+	}
+	diff := cmp.Diff(actual.Spec.ChiaConfig.BootstrapPeers, []string{"node-1"})
+	if diff != "" {
+		t.Errorf("Unexpected bootstrapPeers. Diff: %s", diff)
+	}
+
+	// bootstrapPeers takes precedence over bootstrapPeer
+	src.Spec.ChiaConfig.BootstrapPeers = &[]string{"node-2"}
+	actual = ChiaSeeder{}
+	if err := actual.ConvertFrom(src); err != nil {
+		t.Fatalf("Error converting from v1: %v", err)
+	}
+	diff = cmp.Diff(actual.Spec.ChiaConfig.BootstrapPeers, []string{"node-2"})
+	if diff != "" {
+		t.Errorf("Unexpected bootstrapPeers. Diff: %s", diff)
+	}
+}
+
+func TestFullNodePeersFrom(t *testing.T) {
+	tests := []struct {
+		name          string
+		fullNodePeer  *string
+		fullNodePeers *[]k8schianetv1.Peer
+		expect        []Peer
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:         "fullNodePeer",
+			fullNodePeer: ptr.To("node:8444"),
+			expect:       []Peer{{Host: "node", Port: 8444}},
+		},
+		{
+			name:         "IPv6 fullNodePeer",
+			fullNodePeer: ptr.To("[fd00::1]:8444"),
+			expect:       []Peer{{Host: "fd00::1", Port: 8444}},
+		},
+		{
+			name:         "unparseable fullNodePeer",
+			fullNodePeer: ptr.To("node"),
+			expect:       []Peer{{Host: "node"}},
+		},
+		{
+			name:          "fullNodePeers takes precedence",
+			fullNodePeer:  ptr.To("node-1:8444"),
+			fullNodePeers: &[]k8schianetv1.Peer{{Host: "node-2", Port: 8444}},
+			expect:        []Peer{{Host: "node-2", Port: 8444}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := cmp.Diff(fullNodePeersFrom(tt.fullNodePeer, tt.fullNodePeers), tt.expect)
+			if diff != "" {
+				t.Errorf("Unexpected full_node peers. Diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertStorageFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    *k8schianetv1.StorageConfig
+		node   bool
+		expect *StorageConfig
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "existing claim",
+			src: &k8schianetv1.StorageConfig{
+				ChiaRoot: &k8schianetv1.ChiaRootConfig{
+					PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "chiaroot"},
+				},
+			},
+			expect: &StorageConfig{
+				ChiaRoot: &VolumeConfig{ExistingClaim: &ExistingClaimVolume{ClaimName: "chiaroot"}},
+			},
+		},
+		{
+			name: "generated claim takes precedence over claimName",
+			src: &k8schianetv1.StorageConfig{
+				ChiaRoot: &k8schianetv1.ChiaRootConfig{
+					PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{
+						ClaimName:            "chiaroot",
+						GenerateVolumeClaims: true,
+						ResourceRequest:      "10Gi",
+					},
+				},
+			},
+			expect: &StorageConfig{
+				ChiaRoot: &VolumeConfig{GeneratedClaim: &GeneratedClaimVolume{Size: resource.MustParse("10Gi")}},
+			},
+		},
+		{
+			name: "hostPath",
+			src: &k8schianetv1.StorageConfig{
+				DataLayerServerFiles: &k8schianetv1.DataLayerServerFilesConfig{
+					HostPathVolume: &k8schianetv1.HostPathVolumeConfig{Path: "/mnt/files"},
+				},
+			},
+			expect: &StorageConfig{
+				DataLayerServerFiles: &VolumeConfig{HostPath: &HostPathVolume{Path: "/mnt/files"}},
+			},
+		},
+		{
+			name: "ChiaNodes generate claims without generateVolumeClaims",
+			src: &k8schianetv1.StorageConfig{
+				ChiaRoot: &k8schianetv1.ChiaRootConfig{
+					PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ResourceRequest: "300Gi"},
+				},
+			},
+			node: true,
+			expect: &StorageConfig{
+				ChiaRoot: &VolumeConfig{GeneratedClaim: &GeneratedClaimVolume{Size: resource.MustParse("300Gi")}},
+			},
+		},
+		{
+			name: "ChiaNodes fall back to an emptyDir when the resource request doesn't parse",
+			src: &k8schianetv1.StorageConfig{
+				ChiaRoot: &k8schianetv1.ChiaRootConfig{
+					PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ResourceRequest: "lots"},
+					HostPathVolume:        &k8schianetv1.HostPathVolumeConfig{Path: "/mnt/chiaroot"},
+				},
+			},
+			node: true,
+			expect: &StorageConfig{
+				ChiaRoot: &VolumeConfig{},
+			},
+		},
+		{
+			name: "plots",
+			src: &k8schianetv1.StorageConfig{
+				Plots: &k8schianetv1.PlotsConfig{
+					PersistentVolumeClaim: []*k8schianetv1.PersistentVolumeClaimConfig{{ClaimName: "plots-1"}, nil},
+					HostPathVolume:        []*k8schianetv1.HostPathVolumeConfig{{Path: "/mnt/plots"}},
+				},
+			},
+			expect: &StorageConfig{
+				Plots: &PlotsConfig{
+					ExistingClaims: []ExistingClaimVolume{{ClaimName: "plots-1"}},
+					HostPaths:      []HostPathVolume{{Path: "/mnt/plots"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := cmp.Diff(convertStorageFrom(tt.src, tt.node), tt.expect)
+			if diff != "" {
+				t.Errorf("Unexpected storage config. Diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+// Package v2 contains API Schema definitions for the k8s.chia.net v2 API group
+// +kubebuilder:object:generate=true
+// +groupName=k8s.chia.net
+package v2
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects
+	GroupVersion = schema.GroupVersion{Group: "k8s.chia.net", Version: "v2"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,2915 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"github.com/chia-network/go-chia-libs/pkg/config"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalMetadata) DeepCopyInto(out *AdditionalMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalMetadata.
+func (in *AdditionalMetadata) DeepCopy() *AdditionalMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]autoscalingv2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(autoscalingv2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCA) DeepCopyInto(out *ChiaCA) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCA.
+func (in *ChiaCA) DeepCopy() *ChiaCA {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCA) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAAdopt) DeepCopyInto(out *ChiaCAAdopt) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ChiaCAAdoptSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAAdopt.
+func (in *ChiaCAAdopt) DeepCopy() *ChiaCAAdopt {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAAdopt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAAdoptSecretRef) DeepCopyInto(out *ChiaCAAdoptSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAAdoptSecretRef.
+func (in *ChiaCAAdoptSecretRef) DeepCopy() *ChiaCAAdoptSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAAdoptSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAList) DeepCopyInto(out *ChiaCAList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaCA, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAList.
+func (in *ChiaCAList) DeepCopy() *ChiaCAList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCAList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCARotation) DeepCopyInto(out *ChiaCARotation) {
+	*out = *in
+	if in.TransitionPeriod != nil {
+		in, out := &in.TransitionPeriod, &out.TransitionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCARotation.
+func (in *ChiaCARotation) DeepCopy() *ChiaCARotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCARotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCARotationStatus) DeepCopyInto(out *ChiaCARotationStatus) {
+	*out = *in
+	if in.StageStartTime != nil {
+		in, out := &in.StageStartTime, &out.StageStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCARotationStatus.
+func (in *ChiaCARotationStatus) DeepCopy() *ChiaCARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCASpec) DeepCopyInto(out *ChiaCASpec) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(ChiaCAAdopt)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ChiaCARotation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCASpec.
+func (in *ChiaCASpec) DeepCopy() *ChiaCASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCAStatus) DeepCopyInto(out *ChiaCAStatus) {
+	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ChiaCARotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCAStatus.
+func (in *ChiaCAStatus) DeepCopy() *ChiaCAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCertificates) DeepCopyInto(out *ChiaCertificates) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificates.
+func (in *ChiaCertificates) DeepCopy() *ChiaCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCertificates) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCertificatesList) DeepCopyInto(out *ChiaCertificatesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaCertificates, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificatesList.
+func (in *ChiaCertificatesList) DeepCopy() *ChiaCertificatesList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCertificatesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCertificatesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCertificatesSpec) DeepCopyInto(out *ChiaCertificatesSpec) {
+	*out = *in
+	out.CASecretRef = in.CASecretRef
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificatesSpec.
+func (in *ChiaCertificatesSpec) DeepCopy() *ChiaCertificatesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCertificatesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCertificatesStatus) DeepCopyInto(out *ChiaCertificatesStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRenewalTime != nil {
+		in, out := &in.LastRenewalTime, &out.LastRenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCertificatesStatus.
+func (in *ChiaCertificatesStatus) DeepCopy() *ChiaCertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCertificatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCrawler) DeepCopyInto(out *ChiaCrawler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawler.
+func (in *ChiaCrawler) DeepCopy() *ChiaCrawler {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCrawler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCrawler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCrawlerList) DeepCopyInto(out *ChiaCrawlerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaCrawler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerList.
+func (in *ChiaCrawlerList) DeepCopy() *ChiaCrawlerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCrawlerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaCrawlerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCrawlerSpec) DeepCopyInto(out *ChiaCrawlerSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerSpec.
+func (in *ChiaCrawlerSpec) DeepCopy() *ChiaCrawlerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCrawlerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCrawlerSpecChia) DeepCopyInto(out *ChiaCrawlerSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerSpecChia.
+func (in *ChiaCrawlerSpecChia) DeepCopy() *ChiaCrawlerSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCrawlerSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaCrawlerStatus) DeepCopyInto(out *ChiaCrawlerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = new(CrawlerPeerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaCrawlerStatus.
+func (in *ChiaCrawlerStatus) DeepCopy() *ChiaCrawlerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaCrawlerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaDataLayer) DeepCopyInto(out *ChiaDataLayer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayer.
+func (in *ChiaDataLayer) DeepCopy() *ChiaDataLayer {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaDataLayer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaDataLayer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaDataLayerList) DeepCopyInto(out *ChiaDataLayerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaDataLayer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayerList.
+func (in *ChiaDataLayerList) DeepCopy() *ChiaDataLayerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaDataLayerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaDataLayerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaDataLayerSpec) DeepCopyInto(out *ChiaDataLayerSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	in.FileserverConfig.DeepCopyInto(&out.FileserverConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayerSpec.
+func (in *ChiaDataLayerSpec) DeepCopy() *ChiaDataLayerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaDataLayerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaDataLayerSpecChia) DeepCopyInto(out *ChiaDataLayerSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	out.SecretKeyRef = in.SecretKeyRef
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]Peer, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedCIDRs != nil {
+		in, out := &in.TrustedCIDRs, &out.TrustedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.XCHSpamAmount != nil {
+		in, out := &in.XCHSpamAmount, &out.XCHSpamAmount
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayerSpecChia.
+func (in *ChiaDataLayerSpecChia) DeepCopy() *ChiaDataLayerSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaDataLayerSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaDataLayerStatus) DeepCopyInto(out *ChiaDataLayerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Fileserver != nil {
+		in, out := &in.Fileserver, &out.Fileserver
+		*out = new(WorkloadStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaDataLayerStatus.
+func (in *ChiaDataLayerStatus) DeepCopy() *ChiaDataLayerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaDataLayerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmer) DeepCopyInto(out *ChiaFarmer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmer.
+func (in *ChiaFarmer) DeepCopy() *ChiaFarmer {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaFarmer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerFarmingStatus) DeepCopyInto(out *ChiaFarmerFarmingStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastProofTime != nil {
+		in, out := &in.LastProofTime, &out.LastProofTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Harvesters != nil {
+		in, out := &in.Harvesters, &out.Harvesters
+		*out = make([]ChiaFarmerHarvesterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]ChiaFarmerPoolStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerFarmingStatus.
+func (in *ChiaFarmerFarmingStatus) DeepCopy() *ChiaFarmerFarmingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerFarmingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerHarvesterStatus) DeepCopyInto(out *ChiaFarmerHarvesterStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerHarvesterStatus.
+func (in *ChiaFarmerHarvesterStatus) DeepCopy() *ChiaFarmerHarvesterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerHarvesterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerList) DeepCopyInto(out *ChiaFarmerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaFarmer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerList.
+func (in *ChiaFarmerList) DeepCopy() *ChiaFarmerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaFarmerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerPoolStatus) DeepCopyInto(out *ChiaFarmerPoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerPoolStatus.
+func (in *ChiaFarmerPoolStatus) DeepCopy() *ChiaFarmerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerSpec) DeepCopyInto(out *ChiaFarmerSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerSpec.
+func (in *ChiaFarmerSpec) DeepCopy() *ChiaFarmerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerSpecChia) DeepCopyInto(out *ChiaFarmerSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	out.CASecretRef = in.CASecretRef
+	out.SecretKeyRef = in.SecretKeyRef
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]Peer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerSpecChia.
+func (in *ChiaFarmerSpecChia) DeepCopy() *ChiaFarmerSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaFarmerStatus) DeepCopyInto(out *ChiaFarmerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Farming != nil {
+		in, out := &in.Farming, &out.Farming
+		*out = new(ChiaFarmerFarmingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaFarmerStatus.
+func (in *ChiaFarmerStatus) DeepCopy() *ChiaFarmerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaFarmerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvester) DeepCopyInto(out *ChiaHarvester) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvester.
+func (in *ChiaHarvester) DeepCopy() *ChiaHarvester {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvester)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaHarvester) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterList) DeepCopyInto(out *ChiaHarvesterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaHarvester, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterList.
+func (in *ChiaHarvesterList) DeepCopy() *ChiaHarvesterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaHarvesterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterPlotsStatus) DeepCopyInto(out *ChiaHarvesterPlotsStatus) {
+	*out = *in
+	if in.RawSpace != nil {
+		in, out := &in.RawSpace, &out.RawSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EffectiveSpace != nil {
+		in, out := &in.EffectiveSpace, &out.EffectiveSpace
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterPlotsStatus.
+func (in *ChiaHarvesterPlotsStatus) DeepCopy() *ChiaHarvesterPlotsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterPlotsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterSpec) DeepCopyInto(out *ChiaHarvesterSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterSpec.
+func (in *ChiaHarvesterSpec) DeepCopy() *ChiaHarvesterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterSpecChia) DeepCopyInto(out *ChiaHarvesterSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	out.CASecretRef = in.CASecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterSpecChia.
+func (in *ChiaHarvesterSpecChia) DeepCopy() *ChiaHarvesterSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaHarvesterStatus) DeepCopyInto(out *ChiaHarvesterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Plots != nil {
+		in, out := &in.Plots, &out.Plots
+		*out = new(ChiaHarvesterPlotsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaHarvesterStatus.
+func (in *ChiaHarvesterStatus) DeepCopy() *ChiaHarvesterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaHarvesterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaIntroducer) DeepCopyInto(out *ChiaIntroducer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducer.
+func (in *ChiaIntroducer) DeepCopy() *ChiaIntroducer {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaIntroducer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaIntroducer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaIntroducerList) DeepCopyInto(out *ChiaIntroducerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaIntroducer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerList.
+func (in *ChiaIntroducerList) DeepCopy() *ChiaIntroducerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaIntroducerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaIntroducerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaIntroducerSpec) DeepCopyInto(out *ChiaIntroducerSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerSpec.
+func (in *ChiaIntroducerSpec) DeepCopy() *ChiaIntroducerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaIntroducerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaIntroducerSpecChia) DeepCopyInto(out *ChiaIntroducerSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerSpecChia.
+func (in *ChiaIntroducerSpecChia) DeepCopy() *ChiaIntroducerSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaIntroducerSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaIntroducerStatus) DeepCopyInto(out *ChiaIntroducerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaIntroducerStatus.
+func (in *ChiaIntroducerStatus) DeepCopy() *ChiaIntroducerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaIntroducerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKey) DeepCopyInto(out *ChiaKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKey.
+func (in *ChiaKey) DeepCopy() *ChiaKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeyList) DeepCopyInto(out *ChiaKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeyList.
+func (in *ChiaKeyList) DeepCopy() *ChiaKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeySpec) DeepCopyInto(out *ChiaKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeySpec.
+func (in *ChiaKeySpec) DeepCopy() *ChiaKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaKeyStatus) DeepCopyInto(out *ChiaKeyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaKeyStatus.
+func (in *ChiaKeyStatus) DeepCopy() *ChiaKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNetwork) DeepCopyInto(out *ChiaNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetwork.
+func (in *ChiaNetwork) DeepCopy() *ChiaNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNetworkList) DeepCopyInto(out *ChiaNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetworkList.
+func (in *ChiaNetworkList) DeepCopy() *ChiaNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNetworkSpec) DeepCopyInto(out *ChiaNetworkSpec) {
+	*out = *in
+	if in.NetworkConstants != nil {
+		in, out := &in.NetworkConstants, &out.NetworkConstants
+		*out = new(NetworkConstants)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkConfig != nil {
+		in, out := &in.NetworkConfig, &out.NetworkConfig
+		*out = new(config.NetworkConfig)
+		**out = **in
+	}
+	if in.NetworkName != nil {
+		in, out := &in.NetworkName, &out.NetworkName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPort != nil {
+		in, out := &in.NetworkPort, &out.NetworkPort
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.IntroducerAddress != nil {
+		in, out := &in.IntroducerAddress, &out.IntroducerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSIntroducerAddress != nil {
+		in, out := &in.DNSIntroducerAddress, &out.DNSIntroducerAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetworkSpec.
+func (in *ChiaNetworkSpec) DeepCopy() *ChiaNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNetworkStatus) DeepCopyInto(out *ChiaNetworkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNetworkStatus.
+func (in *ChiaNetworkStatus) DeepCopy() *ChiaNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNode) DeepCopyInto(out *ChiaNode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNode.
+func (in *ChiaNode) DeepCopy() *ChiaNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaNode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeFullNodeStatus) DeepCopyInto(out *ChiaNodeFullNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeFullNodeStatus.
+func (in *ChiaNodeFullNodeStatus) DeepCopy() *ChiaNodeFullNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeFullNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeList) DeepCopyInto(out *ChiaNodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeList.
+func (in *ChiaNodeList) DeepCopy() *ChiaNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaNodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeSpec) DeepCopyInto(out *ChiaNodeSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	in.ChiaHealthcheckConfig.DeepCopyInto(&out.ChiaHealthcheckConfig)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(appsv1.StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeSpec.
+func (in *ChiaNodeSpec) DeepCopy() *ChiaNodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeSpecChia) DeepCopyInto(out *ChiaNodeSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	out.CASecretRef = in.CASecretRef
+	if in.TrustedCIDRs != nil {
+		in, out := &in.TrustedCIDRs, &out.TrustedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]Peer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeSpecChia.
+func (in *ChiaNodeSpecChia) DeepCopy() *ChiaNodeSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaNodeStatus) DeepCopyInto(out *ChiaNodeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.FullNodes != nil {
+		in, out := &in.FullNodes, &out.FullNodes
+		*out = make([]ChiaNodeFullNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaNodeStatus.
+func (in *ChiaNodeStatus) DeepCopy() *ChiaNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaSeeder) DeepCopyInto(out *ChiaSeeder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeeder.
+func (in *ChiaSeeder) DeepCopy() *ChiaSeeder {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaSeeder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaSeeder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaSeederList) DeepCopyInto(out *ChiaSeederList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaSeeder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederList.
+func (in *ChiaSeederList) DeepCopy() *ChiaSeederList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaSeederList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaSeederList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaSeederSpec) DeepCopyInto(out *ChiaSeederSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	in.ChiaHealthcheckConfig.DeepCopyInto(&out.ChiaHealthcheckConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederSpec.
+func (in *ChiaSeederSpec) DeepCopy() *ChiaSeederSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaSeederSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaSeederSpecChia) DeepCopyInto(out *ChiaSeederSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	if in.BootstrapPeers != nil {
+		in, out := &in.BootstrapPeers, &out.BootstrapPeers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinimumHeight != nil {
+		in, out := &in.MinimumHeight, &out.MinimumHeight
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederSpecChia.
+func (in *ChiaSeederSpecChia) DeepCopy() *ChiaSeederSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaSeederSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaSeederStatus) DeepCopyInto(out *ChiaSeederStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = new(CrawlerPeerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaSeederStatus.
+func (in *ChiaSeederStatus) DeepCopy() *ChiaSeederStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaSeederStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaTimelord) DeepCopyInto(out *ChiaTimelord) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelord.
+func (in *ChiaTimelord) DeepCopy() *ChiaTimelord {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaTimelord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaTimelord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaTimelordList) DeepCopyInto(out *ChiaTimelordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaTimelord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelordList.
+func (in *ChiaTimelordList) DeepCopy() *ChiaTimelordList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaTimelordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaTimelordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaTimelordSpec) DeepCopyInto(out *ChiaTimelordSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	in.ChiaHealthcheckConfig.DeepCopyInto(&out.ChiaHealthcheckConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelordSpec.
+func (in *ChiaTimelordSpec) DeepCopy() *ChiaTimelordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaTimelordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaTimelordSpecChia) DeepCopyInto(out *ChiaTimelordSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	out.CASecretRef = in.CASecretRef
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]Peer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelordSpecChia.
+func (in *ChiaTimelordSpecChia) DeepCopy() *ChiaTimelordSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaTimelordSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaTimelordStatus) DeepCopyInto(out *ChiaTimelordStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaTimelordStatus.
+func (in *ChiaTimelordStatus) DeepCopy() *ChiaTimelordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaTimelordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWallet) DeepCopyInto(out *ChiaWallet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWallet.
+func (in *ChiaWallet) DeepCopy() *ChiaWallet {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWallet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaWallet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletList) DeepCopyInto(out *ChiaWalletList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChiaWallet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletList.
+func (in *ChiaWalletList) DeepCopy() *ChiaWalletList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChiaWalletList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletSpec) DeepCopyInto(out *ChiaWalletSpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ChiaConfig.DeepCopyInto(&out.ChiaConfig)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReportBalance != nil {
+		in, out := &in.ReportBalance, &out.ReportBalance
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletSpec.
+func (in *ChiaWalletSpec) DeepCopy() *ChiaWalletSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletSpecChia) DeepCopyInto(out *ChiaWalletSpecChia) {
+	*out = *in
+	in.CommonSpecChia.DeepCopyInto(&out.CommonSpecChia)
+	out.SecretKeyRef = in.SecretKeyRef
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]Peer, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedCIDRs != nil {
+		in, out := &in.TrustedCIDRs, &out.TrustedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.XCHSpamAmount != nil {
+		in, out := &in.XCHSpamAmount, &out.XCHSpamAmount
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletSpecChia.
+func (in *ChiaWalletSpecChia) DeepCopy() *ChiaWalletSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletStatus) DeepCopyInto(out *ChiaWalletStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.WorkloadStatus.DeepCopyInto(&out.WorkloadStatus)
+	if in.Wallet != nil {
+		in, out := &in.Wallet, &out.Wallet
+		*out = new(ChiaWalletSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletStatus.
+func (in *ChiaWalletStatus) DeepCopy() *ChiaWalletStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChiaWalletSyncStatus) DeepCopyInto(out *ChiaWalletSyncStatus) {
+	*out = *in
+	if in.FullNodePeers != nil {
+		in, out := &in.FullNodePeers, &out.FullNodePeers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfirmedBalance != nil {
+		in, out := &in.ConfirmedBalance, &out.ConfirmedBalance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SpendableBalance != nil {
+		in, out := &in.SpendableBalance, &out.SpendableBalance
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChiaWalletSyncStatus.
+func (in *ChiaWalletSyncStatus) DeepCopy() *ChiaWalletSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChiaWalletSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
+	*out = *in
+	in.AdditionalMetadata.DeepCopyInto(&out.AdditionalMetadata)
+	in.ChiaExporterConfig.DeepCopyInto(&out.ChiaExporterConfig)
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]ExtraContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]ExtraContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSpec.
+func (in *CommonSpec) DeepCopy() *CommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonSpecChia) DeepCopyInto(out *CommonSpecChia) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Testnet != nil {
+		in, out := &in.Testnet, &out.Testnet
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChiaNetwork != nil {
+		in, out := &in.ChiaNetwork, &out.ChiaNetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPort != nil {
+		in, out := &in.NetworkPort, &out.NetworkPort
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.IntroducerAddress != nil {
+		in, out := &in.IntroducerAddress, &out.IntroducerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSIntroducerAddress != nil {
+		in, out := &in.DNSIntroducerAddress, &out.DNSIntroducerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.SelfHostname != nil {
+		in, out := &in.SelfHostname, &out.SelfHostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificatesSecretRef != nil {
+		in, out := &in.CertificatesSecretRef, &out.CertificatesSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	in.PeerService.DeepCopyInto(&out.PeerService)
+	in.DaemonService.DeepCopyInto(&out.DaemonService)
+	in.RPCService.DeepCopyInto(&out.RPCService)
+	in.AllService.DeepCopyInto(&out.AllService)
+	if in.AdditionalEnv != nil {
+		in, out := &in.AdditionalEnv, &out.AdditionalEnv
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSpecChia.
+func (in *CommonSpecChia) DeepCopy() *CommonSpecChia {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonSpecChia)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrawlerPeerStatus) DeepCopyInto(out *CrawlerPeerStatus) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]CrawlerPeerVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerPeerStatus.
+func (in *CrawlerPeerStatus) DeepCopy() *CrawlerPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrawlerPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrawlerPeerVersion) DeepCopyInto(out *CrawlerPeerVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerPeerVersion.
+func (in *CrawlerPeerVersion) DeepCopy() *CrawlerPeerVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(CrawlerPeerVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExistingClaimVolume) DeepCopyInto(out *ExistingClaimVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExistingClaimVolume.
+func (in *ExistingClaimVolume) DeepCopy() *ExistingClaimVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ExistingClaimVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtraContainer) DeepCopyInto(out *ExtraContainer) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraContainer.
+func (in *ExtraContainer) DeepCopy() *ExtraContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailingPod) DeepCopyInto(out *FailingPod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailingPod.
+func (in *FailingPod) DeepCopy() *FailingPod {
+	if in == nil {
+		return nil
+	}
+	out := new(FailingPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileserverConfig) DeepCopyInto(out *FileserverConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerFileMountpath != nil {
+		in, out := &in.ServerFileMountpath, &out.ServerFileMountpath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContainerPort != nil {
+		in, out := &in.ContainerPort, &out.ContainerPort
+		*out = new(int)
+		**out = **in
+	}
+	in.Service.DeepCopyInto(&out.Service)
+	in.Ingress.DeepCopyInto(&out.Ingress)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalEnv != nil {
+		in, out := &in.AdditionalEnv, &out.AdditionalEnv
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileserverConfig.
+func (in *FileserverConfig) DeepCopy() *FileserverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FileserverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedClaimVolume) DeepCopyInto(out *GeneratedClaimVolume) {
+	*out = *in
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedClaimVolume.
+func (in *GeneratedClaimVolume) DeepCopy() *GeneratedClaimVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedClaimVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathVolume) DeepCopyInto(out *HostPathVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathVolume.
+func (in *HostPathVolume) DeepCopy() *HostPathVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressConfig) DeepCopyInto(out *IngressConfig) {
+	*out = *in
+	in.AdditionalMetadata.DeepCopyInto(&out.AdditionalMetadata)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = make([]networkingv1.IngressTLS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]networkingv1.IngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressConfig.
+func (in *IngressConfig) DeepCopy() *IngressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConstants) DeepCopyInto(out *NetworkConstants) {
+	*out = *in
+	if in.AggSigMeAdditionalData != nil {
+		in, out := &in.AggSigMeAdditionalData, &out.AggSigMeAdditionalData
+		*out = new(string)
+		**out = **in
+	}
+	if in.DifficultyConstantFactor != nil {
+		in, out := &in.DifficultyConstantFactor, &out.DifficultyConstantFactor
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.DifficultyStarting != nil {
+		in, out := &in.DifficultyStarting, &out.DifficultyStarting
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.EpochBlocks != nil {
+		in, out := &in.EpochBlocks, &out.EpochBlocks
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MempoolBlockBuffer != nil {
+		in, out := &in.MempoolBlockBuffer, &out.MempoolBlockBuffer
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.MinPlotSize != nil {
+		in, out := &in.MinPlotSize, &out.MinPlotSize
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.NetworkType != nil {
+		in, out := &in.NetworkType, &out.NetworkType
+		*out = new(uint8)
+		**out = **in
+	}
+	if in.SubSlotItersStarting != nil {
+		in, out := &in.SubSlotItersStarting, &out.SubSlotItersStarting
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.HardForkHeight != nil {
+		in, out := &in.HardForkHeight, &out.HardForkHeight
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SoftFork4Height != nil {
+		in, out := &in.SoftFork4Height, &out.SoftFork4Height
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SoftFork5Height != nil {
+		in, out := &in.SoftFork5Height, &out.SoftFork5Height
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SoftFork6Height != nil {
+		in, out := &in.SoftFork6Height, &out.SoftFork6Height
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PlotFilter128Height != nil {
+		in, out := &in.PlotFilter128Height, &out.PlotFilter128Height
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PlotFilter64Height != nil {
+		in, out := &in.PlotFilter64Height, &out.PlotFilter64Height
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PlotFilter32Height != nil {
+		in, out := &in.PlotFilter32Height, &out.PlotFilter32Height
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConstants.
+func (in *NetworkConstants) DeepCopy() *NetworkConstants {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConstants)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Peer) DeepCopyInto(out *Peer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Peer.
+func (in *Peer) DeepCopy() *Peer {
+	if in == nil {
+		return nil
+	}
+	out := new(Peer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlotsConfig) DeepCopyInto(out *PlotsConfig) {
+	*out = *in
+	if in.ExistingClaims != nil {
+		in, out := &in.ExistingClaims, &out.ExistingClaims
+		*out = make([]ExistingClaimVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostPaths != nil {
+		in, out := &in.HostPaths, &out.HostPaths
+		*out = make([]HostPathVolume, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlotsConfig.
+func (in *PlotsConfig) DeepCopy() *PlotsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PlotsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetConfig) DeepCopyInto(out *PodDisruptionBudgetConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetConfig.
+func (in *PodDisruptionBudgetConfig) DeepCopy() *PodDisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Service) DeepCopyInto(out *Service) {
+	*out = *in
+	in.AdditionalMetadata.DeepCopyInto(&out.AdditionalMetadata)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(corev1.IPFamilyPolicy)
+		**out = **in
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(corev1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(corev1.ServiceAffinity)
+		**out = **in
+	}
+	if in.SessionAffinityConfig != nil {
+		in, out := &in.SessionAffinityConfig, &out.SessionAffinityConfig
+		*out = new(corev1.SessionAffinityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollIntoPeerService != nil {
+		in, out := &in.RollIntoPeerService, &out.RollIntoPeerService
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Service.
+func (in *Service) DeepCopy() *Service {
+	if in == nil {
+		return nil
+	}
+	out := new(Service)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecChiaExporter) DeepCopyInto(out *SpecChiaExporter) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	in.Service.DeepCopyInto(&out.Service)
+	if in.ConfigSecretRef != nil {
+		in, out := &in.ConfigSecretRef, &out.ConfigSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecChiaExporter.
+func (in *SpecChiaExporter) DeepCopy() *SpecChiaExporter {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecChiaExporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecChiaHealthcheck) DeepCopyInto(out *SpecChiaHealthcheck) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSHostname != nil {
+		in, out := &in.DNSHostname, &out.DNSHostname
+		*out = new(string)
+		**out = **in
+	}
+	in.Service.DeepCopyInto(&out.Service)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecChiaHealthcheck.
+func (in *SpecChiaHealthcheck) DeepCopy() *SpecChiaHealthcheck {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecChiaHealthcheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
+	*out = *in
+	if in.ChiaRoot != nil {
+		in, out := &in.ChiaRoot, &out.ChiaRoot
+		*out = new(VolumeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plots != nil {
+		in, out := &in.Plots, &out.Plots
+		*out = new(PlotsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataLayerServerFiles != nil {
+		in, out := &in.DataLayerServerFiles, &out.DataLayerServerFiles
+		*out = new(VolumeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageConfig.
+func (in *StorageConfig) DeepCopy() *StorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeConfig) DeepCopyInto(out *VolumeConfig) {
+	*out = *in
+	if in.ExistingClaim != nil {
+		in, out := &in.ExistingClaim, &out.ExistingClaim
+		*out = new(ExistingClaimVolume)
+		**out = **in
+	}
+	if in.GeneratedClaim != nil {
+		in, out := &in.GeneratedClaim, &out.GeneratedClaim
+		*out = new(GeneratedClaimVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(HostPathVolume)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeConfig.
+func (in *VolumeConfig) DeepCopy() *VolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadRecreation) DeepCopyInto(out *WorkloadRecreation) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadRecreation.
+func (in *WorkloadRecreation) DeepCopy() *WorkloadRecreation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadRecreation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailingPods != nil {
+		in, out := &in.FailingPods, &out.FailingPods
+		*out = make([]FailingPod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recreation != nil {
+		in, out := &in.Recreation, &out.Recreation
+		*out = new(WorkloadRecreation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
+func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -5,8 +5,11 @@ Copyright 2023 Chia Network Inc.
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
@@ -17,8 +20,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	k8schianetv2 "github.com/chia-network/chia-operator/api/v2"
 	"github.com/chia-network/chia-operator/internal/controller/chiaca"
 	"github.com/chia-network/chia-operator/internal/controller/chiacertificates"
 	"github.com/chia-network/chia-operator/internal/controller/chiacrawler"
@@ -26,23 +31,45 @@ import (
 	"github.com/chia-network/chia-operator/internal/controller/chiafarmer"
 	"github.com/chia-network/chia-operator/internal/controller/chiaharvester"
 	"github.com/chia-network/chia-operator/internal/controller/chiaintroducer"
+	"github.com/chia-network/chia-operator/internal/controller/chiakey"
 	"github.com/chia-network/chia-operator/internal/controller/chianetwork"
 	"github.com/chia-network/chia-operator/internal/controller/chianode"
 	"github.com/chia-network/chia-operator/internal/controller/chiaseeder"
 	"github.com/chia-network/chia-operator/internal/controller/chiatimelord"
 	"github.com/chia-network/chia-operator/internal/controller/chiawallet"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	"github.com/chia-network/chia-operator/internal/metrics"
+	webhookv1 "github.com/chia-network/chia-operator/internal/webhook/v1"
 	//+kubebuilder:scaffold:imports
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// controllerNames are the controllers whose concurrency can be set with a --<name>-max-concurrent-reconciles flag
+	controllerNames = []string{
+		"chiaca",
+		"chiacertificates",
+		"chiacrawler",
+		"chiadatalayer",
+		"chiafarmer",
+		"chiaharvester",
+		"chiaintroducer",
+		"chiakey",
+		"chianetwork",
+		"chianode",
+		"chiaseeder",
+		"chiatimelord",
+		"chiawallet",
+	}
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(k8schianetv1.AddToScheme(scheme))
+	utilruntime.Must(k8schianetv2.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -50,22 +77,65 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var rpcStatusInterval time.Duration
+	var crawlerPeerMetrics bool
+	var defaultMaxConcurrentReconciles int
+	var enableWebhooks bool
+	var webhookCertDir string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&rpcStatusInterval, "rpc-status-interval", time.Minute,
+		"How often to query the RPC servers of chia services to report their state in resource statuses. "+
+			"Set to 0 to disable querying.")
+	flag.BoolVar(&crawlerPeerMetrics, "crawler-peer-metrics", false,
+		"Export the peer counts ChiaCrawlers and ChiaSeeders report in their statuses as operator metrics.")
+	flag.IntVar(&defaultMaxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"The number of resources each controller can reconcile at once. "+
+			"Can be overridden for a controller with its --<controller>-max-concurrent-reconciles flag, such as --chianode-max-concurrent-reconciles.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the validating admission webhooks for Chia resources, and the conversion webhook between their v1 and v2 APIs. "+
+			"Requires a serving certificate in --webhook-cert-dir, and the webhook manifests in config/webhook to be installed.")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "",
+		"The directory containing the webhook server's tls.crt and tls.key. Defaults to /tmp/k8s-webhook-server/serving-certs.")
+	controllerMaxConcurrentReconciles := make(map[string]*int, len(controllerNames))
+	for _, name := range controllerNames {
+		controllerMaxConcurrentReconciles[name] = flag.Int(name+"-max-concurrent-reconciles", 0,
+			fmt.Sprintf("The number of resources the %s controller can reconcile at once. Defaults to --max-concurrent-reconciles if unset.", name))
+	}
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if defaultMaxConcurrentReconciles < 1 {
+		setupLog.Error(fmt.Errorf("--max-concurrent-reconciles must be at least 1, got %d", defaultMaxConcurrentReconciles), "invalid flag")
+		os.Exit(1)
+	}
+	for name, n := range controllerMaxConcurrentReconciles {
+		if *n < 0 {
+			setupLog.Error(fmt.Errorf("--%s-max-concurrent-reconciles must not be negative, got %d", name, *n), "invalid flag")
+			os.Exit(1)
+		}
+	}
+	maxConcurrentReconciles := func(name string) int {
+		if n := *controllerMaxConcurrentReconciles[name]; n > 0 {
+			return n
+		}
+		return defaultMaxConcurrentReconciles
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Metrics: server.Options{
 			BindAddress: metricsAddr,
 		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: webhookCertDir,
+		}),
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "724bee8b.k8s.chia.net",
@@ -86,104 +156,152 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The resource count metrics are computed from the manager's cache, which the controllers share
+	if err = metrics.RegisterResourceCollector(mgr.GetCache()); err != nil {
+		setupLog.Error(err, "unable to register resource metrics")
+		os.Exit(1)
+	}
+
+	// The controllers of every kind of resource that can use a ChiaNetwork share an index of the ChiaNetwork each uses
+	if err = kube.IndexChiaNetworks(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to index resources by ChiaNetwork")
+		os.Exit(1)
+	}
+
 	if err = (&chianode.ChiaNodeReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chianode-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chianode-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chianode"),
+		RPCStatusInterval:       rpcStatusInterval,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaNode")
 		os.Exit(1)
 	}
 	if err = (&chiafarmer.ChiaFarmerReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiafarmer-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiafarmer-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiafarmer"),
+		RPCStatusInterval:       rpcStatusInterval,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaFarmer")
 		os.Exit(1)
 	}
 	if err = (&chiaharvester.ChiaHarvesterReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiaharvester-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiaharvester-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiaharvester"),
+		RPCStatusInterval:       rpcStatusInterval,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaHarvester")
 		os.Exit(1)
 	}
 	if err = (&chiaca.ChiaCAReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiaca-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiaca-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiaca"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaCA")
 		os.Exit(1)
 	}
 	if err = (&chiawallet.ChiaWalletReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiawallet-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiawallet-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiawallet"),
+		RPCStatusInterval:       rpcStatusInterval,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaWallet")
 		os.Exit(1)
 	}
 	if err = (&chiatimelord.ChiaTimelordReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiatimelord-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiatimelord-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiatimelord"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaTimelord")
 		os.Exit(1)
 	}
 	if err = (&chiaseeder.ChiaSeederReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiaseeder-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiaseeder-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiaseeder"),
+		RPCStatusInterval:       rpcStatusInterval,
+		PeerMetrics:             crawlerPeerMetrics,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaSeeder")
 		os.Exit(1)
 	}
 	if err = (&chiaintroducer.ChiaIntroducerReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiaintroducer-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiaintroducer-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiaintroducer"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaIntroducer")
 		os.Exit(1)
 	}
 	if err = (&chiacrawler.ChiaCrawlerReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiacrawler-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiacrawler-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiacrawler"),
+		RPCStatusInterval:       rpcStatusInterval,
+		PeerMetrics:             crawlerPeerMetrics,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaCrawler")
 		os.Exit(1)
 	}
 	if err = (&chianetwork.ChiaNetworkReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chianetwork-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chianetwork-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chianetwork"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaNetwork")
 		os.Exit(1)
 	}
 	if err = (&chiadatalayer.ChiaDataLayerReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiadatalayer-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiadatalayer-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiadatalayer"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaDataLayer")
 		os.Exit(1)
 	}
 	if err = (&chiacertificates.ChiaCertificatesReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chiacertificates-controller"),
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiacertificates-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiacertificates"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChiaCertificates")
 		os.Exit(1)
 	}
+	if err = (&chiakey.ChiaKeyReconciler{
+		Client:                  mgr.GetClient(),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor("chiakey-controller"),
+		MaxConcurrentReconciles: maxConcurrentReconciles("chiakey"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChiaKey")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
+	if enableWebhooks {
+		if err = webhookv1.SetupWebhooksWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -192,6 +310,12 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
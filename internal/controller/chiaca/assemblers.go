@@ -6,8 +6,10 @@ package chiaca
 
 import (
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func assembleCASecret(ca k8schianetv1.ChiaCA, publicCACrt, publicCAKey, privateCACrt, privateCAKey string) corev1.Secret {
@@ -24,3 +26,19 @@ func assembleCASecret(ca k8schianetv1.ChiaCA, publicCACrt, publicCAKey, privateC
 		},
 	}
 }
+
+// assembleSelfSignedIssuer assembles the cert-manager Issuer that self-signs the private CA when no issuer was specified
+func assembleSelfSignedIssuer(ca k8schianetv1.ChiaCA) unstructured.Unstructured {
+	return kube.AssembleCertManagerSelfSignedIssuer(getSelfSignedIssuerName(ca), ca.Namespace, kube.GetCommonLabels(ca.Kind, ca.ObjectMeta))
+}
+
+// assembleCACertificate assembles the cert-manager Certificate for the private CA
+func assembleCACertificate(ca k8schianetv1.ChiaCA) unstructured.Unstructured {
+	issuerRef := k8schianetv1.CertManagerIssuerRef{
+		Name: getSelfSignedIssuerName(ca),
+	}
+	if ca.Spec.IssuerRef != nil {
+		issuerRef = *ca.Spec.IssuerRef
+	}
+	return kube.AssembleCertManagerCACertificate(kube.CertManagerObjectName(ca.Name, "private-ca"), ca.Namespace, kube.GetCommonLabels(ca.Kind, ca.ObjectMeta), getCertManagerSecretName(ca), issuerRef)
+}
@@ -5,6 +5,7 @@ Copyright 2023 Chia Network Inc.
 package chiaca
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -13,15 +14,22 @@ import (
 	"github.com/chia-network/go-chia-libs/pkg/tls"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // ChiaCAReconciler reconciles a ChiaCA object
@@ -29,18 +37,27 @@ type ChiaCAReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles is the number of ChiaCA resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
 }
 
-var chiacas = make(map[string]bool)
+// rotationRequeueInterval is how often a private CA rotation is checked while waiting on workloads to roll out
+const rotationRequeueInterval = 30 * time.Second
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacas,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacas/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacas/finalizers,verbs=update
-//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacertificates,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaCAKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -48,12 +65,6 @@ func (r *ChiaCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	var ca k8schianetv1.ChiaCA
 	err := r.Get(ctx, req.NamespacedName, &ca)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiacas[req.String()]
-		if exists {
-			delete(chiacas, req.String())
-			metrics.ChiaCAs.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -61,34 +72,31 @@ func (r *ChiaCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiacas[req.String()]
-	if !exists {
-		chiacas[req.String()] = true
-		metrics.ChiaCAs.Add(1.0)
-	}
-
 	// Check if CA Secret exists
-	caExists, err := r.caSecretExists(ctx, ca)
+	caSecret, caExists, err := r.getCASecret(ctx, ca)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("encountered error querying for existing CA Secret: %v", err)
 	}
 
+	// cert-manager issues and renews the private CA itself, so it's copied into the CA Secret instead of being generated or adopted
+	if ca.Spec.Backend == k8schianetv1.CertificateBackendCertManager {
+		return r.reconcileCertManager(ctx, &ca, caSecret, caExists)
+	}
+
 	// If CA Secret doesn't exist, generate a CA and create one
 	if !caExists {
 		// Get the public CA cert and key byte slices
 		publicCACrtBytes, publicCAKeyBytes := tls.GetChiaCACertAndKey()
 
-		// Generate a private CA cert and key
-		privateCACrt, privateCAKey, err := tls.GenerateNewCA()
+		// Adopt or generate a private CA cert and key
+		privateCACrtBytes, privateCAKeyBytes, found, err := r.newPrivateCA(ctx, ca)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("encountered error generating new private CA cert and key: %v", err)
+			r.Recorder.Event(&ca, corev1.EventTypeWarning, "Failed", "Failed to create private CA -- Check operator logs.")
+			return ctrl.Result{}, err
 		}
-
-		// Encode the private CA cert and key to PEM byte slices
-		privateCACrtBytes, privateCAKeyBytes, err := tls.EncodeCertAndKeyToPEM(privateCACrt, privateCAKey)
-		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("encountered error encoding private CA cert and key to PEM: %v", err)
+		if !found {
+			log.Info("Secret containing the private CA to adopt not found, cancelling reconciliation and retrying in 10 seconds")
+			return r.markProgressing(ctx, &ca, "WaitingForAdoptedCA", "Waiting for the Secret containing the private CA to adopt")
 		}
 
 		// Assemble CA Secret and create in cluster
@@ -96,13 +104,57 @@ func (r *ChiaCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		if err = r.Create(ctx, &secret); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error creating CA Secret \"%s\": %v", secret.Name, err)
 		}
+
+		// A newly generated or adopted private CA is already at the desired revision, so there's nothing to rotate
+		if ca.Spec.Rotation != nil {
+			ca.Status.Revision = ca.Spec.Rotation.Revision
+		}
+		ca.Status.Rotation = nil
+	} else if problem := validateCASecret(caSecret); problem != nil {
+		// The CA Secret is missing data or was tampered with. Repairing it during a rotation would lose track of the rotating CAs.
+		if ca.Spec.RepairPolicy != k8schianetv1.SecretRepairPolicyRegenerate || rotationInProgress(ca) {
+			log.Info("CA Secret is invalid", "problem", problem.Error())
+			return r.markDegraded(ctx, &ca, problem)
+		}
+
+		log.Info("CA Secret is invalid, repairing", "problem", problem.Error())
+		found, err := r.repairCASecret(ctx, ca, &caSecret)
+		if err != nil {
+			r.Recorder.Event(&ca, corev1.EventTypeWarning, "Failed", "Failed to repair CA Secret -- Check operator logs.")
+			return ctrl.Result{}, fmt.Errorf("encountered error repairing CA Secret: %v", err)
+		}
+		if !found {
+			log.Info("Secret containing the private CA to adopt not found, cancelling reconciliation and retrying in 10 seconds")
+			return r.markProgressing(ctx, &ca, "WaitingForAdoptedCA", "Waiting for the Secret containing the private CA to adopt")
+		}
+		if err = r.Update(ctx, &caSecret); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("error updating CA Secret \"%s\": %v", caSecret.Name, err)
+		}
+		if err = kube.RestartSecretConsumers(ctx, r.Client, ca.Namespace, caSecret.Name, time.Now()); err != nil {
+			r.Recorder.Event(&ca, corev1.EventTypeWarning, "Failed", "Failed to restart workloads mounting the CA Secret -- Check operator logs.")
+			return ctrl.Result{}, fmt.Errorf("encountered error restarting workloads mounting CA Secret: %v", err)
+		}
+		r.Recorder.Event(&ca, corev1.EventTypeNormal, "Repaired",
+			fmt.Sprintf("Repaired invalid CA Secret %s/%s: %v", ca.Namespace, caSecret.Name, problem))
 	}
 
-	if !ca.Status.Ready {
-		r.Recorder.Event(&ca, corev1.EventTypeNormal, "Created",
-			fmt.Sprintf("Successfully created CA Secret in %s/%s", ca.Namespace, ca.Name))
+	if caExists && (rotationRequested(ca) || rotationInProgress(ca)) {
+		return r.reconcileRotation(ctx, &ca, caSecret, nil)
+	}
+
+	conditionsChanged := kube.SetReadyConditions(&ca.Status.Conditions, ca.Generation, "SecretValid", "CA Secret contains a valid public and private CA")
+
+	if !ca.Status.Ready || !caExists || conditionsChanged || ca.Status.ObservedGeneration != ca.Generation {
+		if !ca.Status.Ready || !caExists {
+			r.Recorder.Event(&ca, corev1.EventTypeNormal, "Created",
+				fmt.Sprintf("Successfully created CA Secret in %s/%s", ca.Namespace, ca.Name))
+		}
 
 		ca.Status.Ready = true
+		ca.Status.ObservedGeneration = ca.Generation
 		err = r.Status().Update(ctx, &ca)
 		if err != nil {
 			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -116,9 +168,320 @@ func (r *ChiaCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	return ctrl.Result{}, nil
 }
 
+// reconcileCertManager creates the cert-manager Certificate for the private CA, and copies the private CA cert-manager issues into the CA Secret.
+// Renewed private CAs are rotated to in the same stages as a rotation requested in the spec, so consumers never stop trusting each other's certificates.
+func (r *ChiaCAReconciler) reconcileCertManager(ctx context.Context, ca *k8schianetv1.ChiaCA, caSecret corev1.Secret, caExists bool) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	// Reconcile the self-signed Issuer if the private CA isn't signed by another issuer
+	if ca.Spec.IssuerRef == nil {
+		issuer := assembleSelfSignedIssuer(*ca)
+		if err := controllerutil.SetControllerReference(ca, &issuer, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error assembling self-signed Issuer: %v", err)
+		}
+		res, err := kube.ReconcileCertManagerObject(ctx, r.Client, issuer)
+		if err != nil {
+			r.Recorder.Event(ca, corev1.EventTypeWarning, "Failed", "Failed to create self-signed cert-manager Issuer -- Check operator logs.")
+			return res, err
+		}
+	}
+
+	// Reconcile the private CA Certificate
+	cert := assembleCACertificate(*ca)
+	if err := controllerutil.SetControllerReference(ca, &cert, r.Scheme); err != nil {
+		return ctrl.Result{}, fmt.Errorf("encountered error assembling private CA Certificate: %v", err)
+	}
+	res, err := kube.ReconcileCertManagerObject(ctx, r.Client, cert)
+	if err != nil {
+		r.Recorder.Event(ca, corev1.EventTypeWarning, "Failed", "Failed to create private CA cert-manager Certificate -- Check operator logs.")
+		return res, err
+	}
+
+	// Get the private CA cert-manager issued
+	var issued corev1.Secret
+	err = r.Get(ctx, types.NamespacedName{
+		Namespace: ca.Namespace,
+		Name:      getCertManagerSecretName(*ca),
+	}, &issued)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("encountered error querying for cert-manager issued private CA Secret: %v", err)
+	}
+	privateCACrtBytes := issued.Data[corev1.TLSCertKey]
+	privateCAKeyBytes := issued.Data[corev1.TLSPrivateKeyKey]
+	if len(privateCACrtBytes) == 0 || len(privateCAKeyBytes) == 0 {
+		log.Info("Waiting for cert-manager to issue the private CA, retrying in 10 seconds")
+		return r.markProgressing(ctx, ca, "WaitingForCertManager", "Waiting for cert-manager to issue the private CA")
+	}
+	if err = validatePrivateCA(kube.FirstPEMBlock(privateCACrtBytes), privateCAKeyBytes); err != nil {
+		return r.markDegraded(ctx, ca, fmt.Errorf("cert-manager issued an invalid private CA: %v", err))
+	}
+
+	privateCACrtBytes = kube.FirstPEMBlock(privateCACrtBytes)
+	revision, err := getCertificateRevision(privateCACrtBytes)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !caExists {
+		publicCACrtBytes, publicCAKeyBytes := tls.GetChiaCACertAndKey()
+		secret := assembleCASecret(*ca, string(publicCACrtBytes), string(publicCAKeyBytes), string(privateCACrtBytes), string(privateCAKeyBytes))
+		if err = r.Create(ctx, &secret); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error creating CA Secret \"%s\": %v", secret.Name, err)
+		}
+		r.Recorder.Event(ca, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Successfully created CA Secret in %s/%s", ca.Namespace, ca.Name))
+		ca.Status.Revision = revision
+		ca.Status.Rotation = nil
+	} else if rotationInProgress(*ca) {
+		return r.reconcileRotation(ctx, ca, caSecret, nil)
+	} else if problem := validateCASecret(caSecret); problem != nil {
+		// The CA Secret is missing data or was tampered with, so there's no trusted private CA to rotate away from
+		log.Info("CA Secret is invalid, copying the cert-manager issued private CA into it", "problem", problem.Error())
+		if caSecret.Data == nil {
+			caSecret.Data = make(map[string][]byte)
+		}
+		publicCACrtBytes, publicCAKeyBytes := tls.GetChiaCACertAndKey()
+		caSecret.Data[chiaCACrtKey] = publicCACrtBytes
+		caSecret.Data[chiaCAKeyKey] = publicCAKeyBytes
+		caSecret.Data[privateCACrtKey] = privateCACrtBytes
+		caSecret.Data[privateCAKeyKey] = privateCAKeyBytes
+		delete(caSecret.Data, nextPrivateCACrtKey)
+		delete(caSecret.Data, nextPrivateCAKeyKey)
+		delete(caSecret.Data, previousPrivateCACrtKey)
+		if err = r.Update(ctx, &caSecret); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("error updating CA Secret \"%s\": %v", caSecret.Name, err)
+		}
+		if err = kube.RestartSecretConsumers(ctx, r.Client, ca.Namespace, caSecret.Name, time.Now()); err != nil {
+			r.Recorder.Event(ca, corev1.EventTypeWarning, "Failed", "Failed to restart workloads mounting the CA Secret -- Check operator logs.")
+			return ctrl.Result{}, fmt.Errorf("encountered error restarting workloads mounting CA Secret: %v", err)
+		}
+		r.Recorder.Event(ca, corev1.EventTypeNormal, "Repaired",
+			fmt.Sprintf("Repaired invalid CA Secret %s/%s: %v", ca.Namespace, caSecret.Name, problem))
+		ca.Status.Revision = revision
+	} else if !bytes.Equal(kube.FirstPEMBlock(caSecret.Data[privateCACrtKey]), privateCACrtBytes) || !bytes.Equal(caSecret.Data[privateCAKeyKey], privateCAKeyBytes) {
+		// cert-manager renewed the private CA, so rotate to it
+		log.Info("cert-manager renewed the private CA, starting a rotation", "revision", revision)
+		return r.reconcileRotation(ctx, ca, caSecret, &rotationTarget{
+			revision: revision,
+			crt:      privateCACrtBytes,
+			key:      privateCAKeyBytes,
+		})
+	}
+
+	conditionsChanged := kube.SetReadyConditions(&ca.Status.Conditions, ca.Generation, "SecretValid", "CA Secret contains the private CA issued by cert-manager")
+	if !ca.Status.Ready || !caExists || conditionsChanged || ca.Status.ObservedGeneration != ca.Generation || ca.Status.Revision != revision {
+		ca.Status.Ready = true
+		ca.Status.ObservedGeneration = ca.Generation
+		ca.Status.Revision = revision
+		if err = r.Status().Update(ctx, ca); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			log.Error(err, "encountered error updating ChiaCA status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// markDegraded sets the ChiaCA's Degraded condition to explain why its CA Secret is invalid
+func (r *ChiaCAReconciler) markDegraded(ctx context.Context, ca *k8schianetv1.ChiaCA, problem error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaCAKind), "secret")
+	changed := kube.SetCondition(&ca.Status.Conditions, ca.Generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionTrue, "InvalidSecret", problem.Error())
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	r.Recorder.Event(ca, corev1.EventTypeWarning, "Degraded", fmt.Sprintf("CA Secret is invalid: %v", problem))
+	ca.Status.ObservedGeneration = ca.Generation
+	if err := r.Status().Update(ctx, ca); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		log.FromContext(ctx).Error(err, "encountered error updating ChiaCA status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// markProgressing sets the ChiaCA's Progressing condition while it waits on something before the CA Secret can be created, and requeues to check again
+func (r *ChiaCAReconciler) markProgressing(ctx context.Context, ca *k8schianetv1.ChiaCA, reason, message string) (ctrl.Result, error) {
+	if kube.SetProgressingConditions(&ca.Status.Conditions, ca.Generation, reason, message) {
+		ca.Status.ObservedGeneration = ca.Generation
+		if err := r.Status().Update(ctx, ca); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			log.FromContext(ctx).Error(err, "encountered error updating ChiaCA status")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// reconcileRotation moves a private CA rotation forward by one stage once the current stage has finished.
+// Every stage lasts at least the configured transition period, and waits for all workloads mounting the CA Secret to roll out.
+// A new rotation moves to the given target, or to a newly generated private CA at the spec's revision if target is nil.
+func (r *ChiaCAReconciler) reconcileRotation(ctx context.Context, ca *k8schianetv1.ChiaCA, secret corev1.Secret, target *rotationTarget) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	secretName := getChiaCASecretName(*ca)
+	now := time.Now()
+
+	if rotationInProgress(*ca) {
+		if ca.Status.Rotation.StageStartTime != nil {
+			stageEnd := ca.Status.Rotation.StageStartTime.Add(getTransitionPeriod(*ca))
+			if now.Before(stageEnd) {
+				return ctrl.Result{RequeueAfter: stageEnd.Sub(now)}, nil
+			}
+		}
+
+		rolledOut, err := kube.SecretConsumersRolledOut(ctx, r.Client, ca.Namespace, secretName)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error checking rollout of workloads mounting CA Secret: %v", err)
+		}
+		if !rolledOut {
+			log.Info("Waiting for workloads mounting the CA Secret to roll out", "stage", ca.Status.Rotation.Stage)
+			return ctrl.Result{RequeueAfter: rotationRequeueInterval}, nil
+		}
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+
+	var nextStage k8schianetv1.ChiaCARotationStage
+	stage := k8schianetv1.ChiaCARotationStageComplete
+	if ca.Status.Rotation != nil {
+		stage = ca.Status.Rotation.Stage
+	}
+	switch stage {
+	case k8schianetv1.ChiaCARotationStageTrustBundle:
+		if err := promoteNextCA(&secret); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error promoting next private CA: %v", err)
+		}
+		nextStage = k8schianetv1.ChiaCARotationStageReissue
+	case k8schianetv1.ChiaCARotationStageReissue:
+		reissued, err := r.dependentCertificatesReissued(ctx, *ca, secret.Data[privateCACrtKey])
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error checking dependent ChiaCertificates: %v", err)
+		}
+		if !reissued {
+			log.Info("Waiting for dependent ChiaCertificates to be re-issued by the new private CA")
+			return ctrl.Result{RequeueAfter: rotationRequeueInterval}, nil
+		}
+		if err := retirePreviousCA(&secret); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error retiring previous private CA: %v", err)
+		}
+		nextStage = k8schianetv1.ChiaCARotationStageRetire
+	case k8schianetv1.ChiaCARotationStageRetire:
+		ca.Status.Revision = ca.Status.Rotation.TargetRevision
+		nextStage = k8schianetv1.ChiaCARotationStageComplete
+	default:
+		if target == nil {
+			nextCrt, nextKey, err := generatePrivateCA()
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			target = &rotationTarget{
+				revision: ca.Spec.Rotation.Revision,
+				crt:      nextCrt,
+				key:      nextKey,
+			}
+		}
+		if err := publishTrustBundle(&secret, target.crt, target.key); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error publishing private CA trust bundle: %v", err)
+		}
+		ca.Status.Rotation = &k8schianetv1.ChiaCARotationStatus{
+			TargetRevision: target.revision,
+		}
+		nextStage = k8schianetv1.ChiaCARotationStageTrustBundle
+	}
+
+	if nextStage != k8schianetv1.ChiaCARotationStageComplete {
+		if err := r.Update(ctx, &secret); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("error updating CA Secret \"%s\": %v", secret.Name, err)
+		}
+
+		if err := kube.RestartSecretConsumers(ctx, r.Client, ca.Namespace, secretName, now); err != nil {
+			r.Recorder.Event(ca, corev1.EventTypeWarning, "Failed", "Failed to restart workloads mounting the CA Secret -- Check operator logs.")
+			return ctrl.Result{}, fmt.Errorf("encountered error restarting workloads mounting CA Secret: %v", err)
+		}
+	}
+
+	r.Recorder.Event(ca, corev1.EventTypeNormal, "Rotating",
+		fmt.Sprintf("Private CA rotation to revision %s entered stage %s", ca.Status.Rotation.TargetRevision, nextStage))
+
+	ca.Status.Ready = true
+	ca.Status.ObservedGeneration = ca.Generation
+	ca.Status.Rotation.Stage = nextStage
+	ca.Status.Rotation.StageStartTime = &metav1.Time{Time: now}
+	kube.SetCondition(&ca.Status.Conditions, ca.Generation, k8schianetv1.ConditionTypeReady, metav1.ConditionTrue, "SecretValid", "CA Secret contains a valid public and private CA")
+	kube.SetCondition(&ca.Status.Conditions, ca.Generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionFalse, "SecretValid", "CA Secret contains a valid public and private CA")
+	if nextStage == k8schianetv1.ChiaCARotationStageComplete {
+		kube.SetCondition(&ca.Status.Conditions, ca.Generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionFalse, "RotationComplete",
+			fmt.Sprintf("Private CA rotated to revision %s", ca.Status.Revision))
+	} else {
+		kube.SetCondition(&ca.Status.Conditions, ca.Generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionTrue, "Rotating",
+			fmt.Sprintf("Private CA rotation to revision %s is in stage %s", ca.Status.Rotation.TargetRevision, nextStage))
+	}
+	if err := r.Status().Update(ctx, ca); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		log.Error(err, "encountered error updating ChiaCA status")
+		return ctrl.Result{}, err
+	}
+
+	if nextStage == k8schianetv1.ChiaCARotationStageComplete {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: getTransitionPeriod(*ca)}, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaCAReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaCAs by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaCA{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaCA))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8schianetv1.ChiaCA{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleCASecrets),
+		).
 		Complete(r)
 }
+
+// handleCASecrets enqueues the ChiaCA that manages a Secret, so changes to the CA Secret are validated,
+// and private CAs issued by cert-manager are copied into the CA Secret
+func (r *ChiaCAReconciler) handleCASecrets(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &k8schianetv1.ChiaCAList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
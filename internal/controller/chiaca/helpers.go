@@ -5,32 +5,285 @@ Copyright 2023 Chia Network Inc.
 package chiaca
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
+	"github.com/chia-network/go-chia-libs/pkg/tls"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 )
 
-// caSecretExists fetches the k8s Secret that matches this ChiaCA deployment. Returns true if the Secret exists.
-func (r *ChiaCAReconciler) caSecretExists(ctx context.Context, ca k8schianetv1.ChiaCA) (bool, error) {
+const (
+	// chiaCACrtKey is the CA Secret key for the public Chia CA certificate
+	chiaCACrtKey = "chia_ca.crt"
+
+	// chiaCAKeyKey is the CA Secret key for the public Chia CA key
+	chiaCAKeyKey = "chia_ca.key"
+
+	// privateCACrtKey is the CA Secret key for the private CA certificate, or the private CA trust bundle during a rotation.
+	// The first certificate in this file is always the one that signs new certificates.
+	privateCACrtKey = "private_ca.crt"
+
+	// privateCAKeyKey is the CA Secret key for the private CA key that signs new certificates
+	privateCAKeyKey = "private_ca.key"
+
+	// nextPrivateCACrtKey is the CA Secret key for the private CA certificate being rotated to, before it starts signing certificates
+	nextPrivateCACrtKey = "next_private_ca.crt"
+
+	// nextPrivateCAKeyKey is the CA Secret key for the private CA key being rotated to, before it starts signing certificates
+	nextPrivateCAKeyKey = "next_private_ca.key"
+
+	// previousPrivateCACrtKey is the CA Secret key for the private CA certificate being rotated away from, after it stopped signing certificates
+	previousPrivateCACrtKey = "previous_private_ca.crt"
+
+	// defaultTransitionPeriod is the default minimum amount of time each stage of a private CA rotation lasts
+	defaultTransitionPeriod = time.Hour
+)
+
+// rotationTarget is the private CA a rotation moves to
+type rotationTarget struct {
+	// revision identifies the private CA in the ChiaCA's status
+	revision string
+
+	// crt and key are the PEM encoded private CA certificate and key
+	crt []byte
+	key []byte
+}
+
+// getCASecret fetches the k8s Secret that matches this ChiaCA deployment. Returns true if the Secret exists.
+func (r *ChiaCAReconciler) getCASecret(ctx context.Context, ca k8schianetv1.ChiaCA) (corev1.Secret, bool, error) {
 	var secret corev1.Secret
 	err := r.Get(ctx, types.NamespacedName{
 		Namespace: ca.Namespace,
 		Name:      getChiaCASecretName(ca),
 	}, &secret)
 	if err != nil && errors.IsNotFound(err) {
-		return false, nil
+		return corev1.Secret{}, false, nil
+	}
+	if err != nil {
+		return corev1.Secret{}, false, err
+	}
+	return secret, true, nil
+}
+
+// dependentCertificatesReissued returns true if every ChiaCertificates that uses this CA's Secret has certificates signed by the CA's current signing certificate,
+// and every workload mounting those certificates has rolled out.
+func (r *ChiaCAReconciler) dependentCertificatesReissued(ctx context.Context, ca k8schianetv1.ChiaCA, caCert []byte) (bool, error) {
+	signer, err := tls.ParsePemCertificate(kube.FirstPEMBlock(caCert))
+	if err != nil {
+		return false, fmt.Errorf("parsing private CA certificate: %v", err)
+	}
+
+	var list k8schianetv1.ChiaCertificatesList
+	err = r.List(ctx, &list, &client.ListOptions{
+		Namespace: ca.Namespace,
+	})
+	if err != nil {
+		return false, fmt.Errorf("listing ChiaCertificates: %v", err)
+	}
+
+	for _, certs := range list.Items {
+		if certs.Spec.CASecretName != getChiaCASecretName(ca) {
+			continue
+		}
+
+		secretName := certs.Name
+		if strings.TrimSpace(certs.Spec.Secret) != "" {
+			secretName = certs.Spec.Secret
+		}
+
+		var secret corev1.Secret
+		err = r.Get(ctx, types.NamespacedName{
+			Namespace: certs.Namespace,
+			Name:      secretName,
+		}, &secret)
+		if err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+
+		for name, data := range secret.Data {
+			// Public certificates are signed by Chia's public CA, not the private CA
+			if !strings.HasPrefix(name, "private_") || !strings.HasSuffix(name, ".crt") {
+				continue
+			}
+			cert, err := tls.ParsePemCertificate(data)
+			if err != nil || cert.CheckSignatureFrom(signer) != nil {
+				return false, nil
+			}
+		}
+
+		rolledOut, err := kube.SecretConsumersRolledOut(ctx, r.Client, certs.Namespace, secretName)
+		if err != nil || !rolledOut {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// getAdoptedCA returns the PEM encoded certificate and key of the existing private CA this ChiaCA was configured to adopt.
+// Returns false if the adopted CA references a Secret that doesn't exist.
+func (r *ChiaCAReconciler) getAdoptedCA(ctx context.Context, ca k8schianetv1.ChiaCA) ([]byte, []byte, bool, error) {
+	adopt := ca.Spec.Adopt
+	if adopt.SecretRef == nil {
+		if adopt.Certificate == "" || adopt.PrivateKey == "" {
+			return nil, nil, false, fmt.Errorf("adopting a private CA requires either a Secret reference, or both an inline certificate and private key")
+		}
+		return []byte(adopt.Certificate), []byte(adopt.PrivateKey), true, nil
+	}
+
+	var secret corev1.Secret
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: ca.Namespace,
+		Name:      adopt.SecretRef.Name,
+	}, &secret)
+	if err != nil && errors.IsNotFound(err) {
+		return nil, nil, false, nil
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	crtKey := privateCACrtKey
+	if adopt.SecretRef.CertificateKey != "" {
+		crtKey = adopt.SecretRef.CertificateKey
+	}
+	keyKey := privateCAKeyKey
+	if adopt.SecretRef.PrivateKeyKey != "" {
+		keyKey = adopt.SecretRef.PrivateKeyKey
 	}
+
+	crt, ok := secret.Data[crtKey]
+	if !ok {
+		return nil, nil, false, fmt.Errorf("key \"%s\" not present in Secret \"%s\"", crtKey, secret.Name)
+	}
+	key, ok := secret.Data[keyKey]
+	if !ok {
+		return nil, nil, false, fmt.Errorf("key \"%s\" not present in Secret \"%s\"", keyKey, secret.Name)
+	}
+	return crt, key, true, nil
+}
+
+// validatePrivateCA checks that a PEM encoded certificate is a CA certificate, and that the PEM encoded key is its private key
+func validatePrivateCA(crt, key []byte) error {
+	cert, err := tls.ParsePemCertificate(crt)
+	if err != nil {
+		return fmt.Errorf("parsing certificate: %v", err)
+	}
+	privateKey, err := tls.ParsePemKey(key)
+	if err != nil {
+		return fmt.Errorf("parsing key: %v", err)
+	}
+	if !cert.IsCA {
+		return fmt.Errorf("certificate is not a CA certificate")
+	}
+	if !tls.CertMatchesPrivateKey(cert, privateKey) {
+		return fmt.Errorf("key does not match the certificate")
+	}
+	return nil
+}
+
+// newPrivateCA returns the PEM encoded certificate and key for a new private CA, which is adopted from the ChiaCA's configured existing CA if set, or generated otherwise.
+// Returns false if the adopted CA references a Secret that doesn't exist.
+func (r *ChiaCAReconciler) newPrivateCA(ctx context.Context, ca k8schianetv1.ChiaCA) ([]byte, []byte, bool, error) {
+	if ca.Spec.Adopt == nil {
+		crt, key, err := generatePrivateCA()
+		return crt, key, true, err
+	}
+
+	crt, key, found, err := r.getAdoptedCA(ctx, ca)
 	if err != nil {
-		return false, err
+		return nil, nil, false, fmt.Errorf("encountered error reading the private CA to adopt: %v", err)
 	}
+	if !found {
+		return nil, nil, false, nil
+	}
+	if err = validatePrivateCA(crt, key); err != nil {
+		return nil, nil, false, fmt.Errorf("private CA to adopt is invalid: %v", err)
+	}
+	return crt, key, true, nil
+}
+
+// repairCASecret replaces the invalid CA certificate and key pairs in the CA Secret.
+// The public Chia CA is restored from go-chia-libs, and an invalid private CA is replaced with a new private CA.
+// Returns false if the private CA needs to be adopted from a Secret that doesn't exist.
+func (r *ChiaCAReconciler) repairCASecret(ctx context.Context, ca k8schianetv1.ChiaCA, secret *corev1.Secret) (bool, error) {
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+
+	if validateCAKeyPair(*secret, chiaCACrtKey, chiaCAKeyKey) != nil {
+		publicCACrtBytes, publicCAKeyBytes := tls.GetChiaCACertAndKey()
+		secret.Data[chiaCACrtKey] = publicCACrtBytes
+		secret.Data[chiaCAKeyKey] = publicCAKeyBytes
+	}
+
+	if validateCAKeyPair(*secret, privateCACrtKey, privateCAKeyKey) != nil {
+		crt, key, found, err := r.newPrivateCA(ctx, ca)
+		if err != nil || !found {
+			return found, err
+		}
+		secret.Data[privateCACrtKey] = crt
+		secret.Data[privateCAKeyKey] = key
+		delete(secret.Data, nextPrivateCACrtKey)
+		delete(secret.Data, nextPrivateCAKeyKey)
+		delete(secret.Data, previousPrivateCACrtKey)
+	}
+
 	return true, nil
 }
 
+// validateCASecret checks that the CA Secret contains a valid public Chia CA and private CA. The returned error describes every problem found.
+func validateCASecret(secret corev1.Secret) error {
+	var problems []string
+	for _, pair := range [][2]string{
+		{chiaCACrtKey, chiaCAKeyKey},
+		{privateCACrtKey, privateCAKeyKey},
+	} {
+		if err := validateCAKeyPair(secret, pair[0], pair[1]); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateCAKeyPair checks that the Secret contains a CA certificate and its matching key at the given keys.
+// The certificate may be a trust bundle, in which case the first certificate must match the key.
+func validateCAKeyPair(secret corev1.Secret, crtKey, keyKey string) error {
+	crt, ok := secret.Data[crtKey]
+	if !ok || len(crt) == 0 {
+		return fmt.Errorf("%s is missing", crtKey)
+	}
+	key, ok := secret.Data[keyKey]
+	if !ok || len(key) == 0 {
+		return fmt.Errorf("%s is missing", keyKey)
+	}
+	if err := validatePrivateCA(kube.FirstPEMBlock(crt), key); err != nil {
+		return fmt.Errorf("%s and %s: %v", crtKey, keyKey, err)
+	}
+	return nil
+}
+
+// getCertificateRevision returns the revision that identifies a private CA issued by cert-manager, which is its certificate's serial number
+func getCertificateRevision(crt []byte) (string, error) {
+	cert, err := tls.ParsePemCertificate(crt)
+	if err != nil {
+		return "", fmt.Errorf("parsing private CA certificate: %v", err)
+	}
+	return cert.SerialNumber.Text(16), nil
+}
+
 // getChiaCASecretName gets the name of the Secret to check if it exists
 func getChiaCASecretName(ca k8schianetv1.ChiaCA) string {
 	secretName := ca.Name
@@ -39,3 +292,108 @@ func getChiaCASecretName(ca k8schianetv1.ChiaCA) string {
 	}
 	return secretName
 }
+
+// secretRefs returns the names of the Secrets a ChiaCA manages, which is its CA Secret, and the Secret cert-manager issues the private CA into with the CertManager backend
+func secretRefs(ca k8schianetv1.ChiaCA) []string {
+	refs := []string{getChiaCASecretName(ca)}
+	if ca.Spec.Backend == k8schianetv1.CertificateBackendCertManager {
+		refs = append(refs, getCertManagerSecretName(ca))
+	}
+	return refs
+}
+
+// getCertManagerSecretName gets the name of the Secret cert-manager issues the private CA into, when using the CertManager backend
+func getCertManagerSecretName(ca k8schianetv1.ChiaCA) string {
+	return getChiaCASecretName(ca) + "-private-ca"
+}
+
+// getSelfSignedIssuerName gets the name of the cert-manager Issuer that self-signs the private CA, when using the CertManager backend
+func getSelfSignedIssuerName(ca k8schianetv1.ChiaCA) string {
+	return kube.CertManagerObjectName(ca.Name, "selfsigned")
+}
+
+// getTransitionPeriod returns the configured minimum duration of a rotation stage, or the default if unset
+func getTransitionPeriod(ca k8schianetv1.ChiaCA) time.Duration {
+	if ca.Spec.Rotation != nil && ca.Spec.Rotation.TransitionPeriod != nil && ca.Spec.Rotation.TransitionPeriod.Duration > 0 {
+		return ca.Spec.Rotation.TransitionPeriod.Duration
+	}
+	return defaultTransitionPeriod
+}
+
+// rotationRequested returns true if the spec requests a private CA revision other than the one in use
+func rotationRequested(ca k8schianetv1.ChiaCA) bool {
+	return ca.Spec.Rotation != nil && ca.Spec.Rotation.Revision != ca.Status.Revision
+}
+
+// rotationInProgress returns true if a private CA rotation has started and not yet completed
+func rotationInProgress(ca k8schianetv1.ChiaCA) bool {
+	return ca.Status.Rotation != nil && ca.Status.Rotation.Stage != "" && ca.Status.Rotation.Stage != k8schianetv1.ChiaCARotationStageComplete
+}
+
+// generatePrivateCA generates a new private CA and returns the PEM encoded certificate and key
+func generatePrivateCA() ([]byte, []byte, error) {
+	privateCACrt, privateCAKey, err := tls.GenerateNewCA()
+	if err != nil {
+		return nil, nil, fmt.Errorf("encountered error generating new private CA cert and key: %v", err)
+	}
+
+	privateCACrtBytes, privateCAKeyBytes, err := tls.EncodeCertAndKeyToPEM(privateCACrt, privateCAKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encountered error encoding private CA cert and key to PEM: %v", err)
+	}
+
+	return privateCACrtBytes, privateCAKeyBytes, nil
+}
+
+// publishTrustBundle adds the next private CA to the CA Secret and publishes a trust bundle with both the current and next CA certificates.
+// The current private CA remains first in the bundle, so it continues to sign new certificates.
+func publishTrustBundle(secret *corev1.Secret, nextCrt, nextKey []byte) error {
+	currentCrt := kube.FirstPEMBlock(secret.Data[privateCACrtKey])
+	if currentCrt == nil {
+		return fmt.Errorf("private CA certificate not present in CA Secret")
+	}
+
+	secret.Data[nextPrivateCACrtKey] = nextCrt
+	secret.Data[nextPrivateCAKeyKey] = nextKey
+	secret.Data[privateCACrtKey] = bytes.Join([][]byte{currentCrt, nextCrt}, nil)
+	return nil
+}
+
+// promoteNextCA makes the next private CA the signing CA in the CA Secret, while keeping the previous private CA in the trust bundle.
+func promoteNextCA(secret *corev1.Secret) error {
+	nextCrt, ok := secret.Data[nextPrivateCACrtKey]
+	if _, promoted := secret.Data[previousPrivateCACrtKey]; !ok && promoted {
+		// Already promoted, likely by a previous reconcile that failed to update the ChiaCA's status
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("next private CA certificate not present in CA Secret")
+	}
+	nextKey, ok := secret.Data[nextPrivateCAKeyKey]
+	if !ok {
+		return fmt.Errorf("next private CA key not present in CA Secret")
+	}
+	previousCrt := kube.FirstPEMBlock(secret.Data[privateCACrtKey])
+	if previousCrt == nil {
+		return fmt.Errorf("private CA certificate not present in CA Secret")
+	}
+
+	secret.Data[previousPrivateCACrtKey] = previousCrt
+	secret.Data[privateCACrtKey] = bytes.Join([][]byte{nextCrt, previousCrt}, nil)
+	secret.Data[privateCAKeyKey] = nextKey
+	delete(secret.Data, nextPrivateCACrtKey)
+	delete(secret.Data, nextPrivateCAKeyKey)
+	return nil
+}
+
+// retirePreviousCA removes the previous private CA from the trust bundle in the CA Secret
+func retirePreviousCA(secret *corev1.Secret) error {
+	currentCrt := kube.FirstPEMBlock(secret.Data[privateCACrtKey])
+	if currentCrt == nil {
+		return fmt.Errorf("private CA certificate not present in CA Secret")
+	}
+
+	secret.Data[privateCACrtKey] = currentCrt
+	delete(secret.Data, previousPrivateCACrtKey)
+	return nil
+}
@@ -5,11 +5,17 @@ Copyright 2025 Chia Network Inc.
 package chiaca
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/go-chia-libs/pkg/tls"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 var testChiaCA = k8schianetv1.ChiaCA{
@@ -52,3 +58,223 @@ func TestGetChiaCASecretName_WhitespaceString(t *testing.T) {
 	secretName := getChiaCASecretName(customCA)
 	assert.Equal(t, "testname", secretName)
 }
+
+func TestSecretRefs(t *testing.T) {
+	ca := testChiaCA
+	assert.Equal(t, []string{"testname"}, secretRefs(ca))
+
+	ca.Spec.Backend = k8schianetv1.CertificateBackendCertManager
+	assert.Equal(t, []string{"testname", "testname-private-ca"}, secretRefs(ca))
+}
+
+func TestGetTransitionPeriod(t *testing.T) {
+	assert.Equal(t, defaultTransitionPeriod, getTransitionPeriod(testChiaCA))
+
+	customCA := testChiaCA
+	customCA.Spec.Rotation = &k8schianetv1.ChiaCARotation{
+		Revision:         "2",
+		TransitionPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	assert.Equal(t, 10*time.Minute, getTransitionPeriod(customCA))
+}
+
+func TestRotationRequested(t *testing.T) {
+	// No rotation configured
+	assert.False(t, rotationRequested(testChiaCA))
+
+	customCA := testChiaCA
+	customCA.Spec.Rotation = &k8schianetv1.ChiaCARotation{
+		Revision: "2",
+	}
+	customCA.Status.Revision = "1"
+	assert.True(t, rotationRequested(customCA))
+
+	customCA.Status.Revision = "2"
+	assert.False(t, rotationRequested(customCA))
+}
+
+func TestRotationInProgress(t *testing.T) {
+	assert.False(t, rotationInProgress(testChiaCA))
+
+	customCA := testChiaCA
+	customCA.Status.Rotation = &k8schianetv1.ChiaCARotationStatus{
+		Stage: k8schianetv1.ChiaCARotationStageReissue,
+	}
+	assert.True(t, rotationInProgress(customCA))
+
+	customCA.Status.Rotation.Stage = k8schianetv1.ChiaCARotationStageComplete
+	assert.False(t, rotationInProgress(customCA))
+}
+
+func TestRotationStages(t *testing.T) {
+	oldCrt, oldKey, err := generatePrivateCA()
+	require.NoError(t, err)
+	newCrt, newKey, err := generatePrivateCA()
+	require.NoError(t, err)
+
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			privateCACrtKey: oldCrt,
+			privateCAKeyKey: oldKey,
+		},
+	}
+
+	// Trust bundle: old CA still signs, both CAs trusted
+	require.NoError(t, publishTrustBundle(&secret, newCrt, newKey))
+	assert.Equal(t, append(append([]byte{}, oldCrt...), newCrt...), secret.Data[privateCACrtKey])
+	assert.Equal(t, oldKey, secret.Data[privateCAKeyKey])
+	assert.Equal(t, newCrt, secret.Data[nextPrivateCACrtKey])
+	assert.Equal(t, newKey, secret.Data[nextPrivateCAKeyKey])
+
+	// Reissue: new CA signs, both CAs trusted
+	require.NoError(t, promoteNextCA(&secret))
+	assert.Equal(t, append(append([]byte{}, newCrt...), oldCrt...), secret.Data[privateCACrtKey])
+	assert.Equal(t, newKey, secret.Data[privateCAKeyKey])
+	assert.Equal(t, oldCrt, secret.Data[previousPrivateCACrtKey])
+	assert.NotContains(t, secret.Data, nextPrivateCACrtKey)
+	assert.NotContains(t, secret.Data, nextPrivateCAKeyKey)
+
+	// Promoting again is a no-op
+	require.NoError(t, promoteNextCA(&secret))
+	assert.Equal(t, newKey, secret.Data[privateCAKeyKey])
+
+	// Retire: only the new CA is trusted
+	require.NoError(t, retirePreviousCA(&secret))
+	assert.Equal(t, newCrt, secret.Data[privateCACrtKey])
+	assert.Equal(t, newKey, secret.Data[privateCAKeyKey])
+	assert.NotContains(t, secret.Data, previousPrivateCACrtKey)
+}
+
+func TestGetCertificateRevision(t *testing.T) {
+	crt, _, err := generatePrivateCA()
+	require.NoError(t, err)
+	cert, err := tls.ParsePemCertificate(crt)
+	require.NoError(t, err)
+
+	revision, err := getCertificateRevision(crt)
+	require.NoError(t, err)
+	assert.Equal(t, cert.SerialNumber.Text(16), revision)
+
+	_, err = getCertificateRevision([]byte("not a certificate"))
+	assert.Error(t, err)
+}
+
+func TestPromoteNextCA_MissingNextCA(t *testing.T) {
+	crt, key, err := generatePrivateCA()
+	require.NoError(t, err)
+
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			privateCACrtKey: crt,
+			privateCAKeyKey: key,
+		},
+	}
+	assert.Error(t, promoteNextCA(&secret))
+}
+
+func TestValidatePrivateCA(t *testing.T) {
+	crt, key, err := generatePrivateCA()
+	require.NoError(t, err)
+	require.NoError(t, validatePrivateCA(crt, key))
+
+	// Key belongs to a different CA
+	_, otherKey, err := generatePrivateCA()
+	require.NoError(t, err)
+	assert.Error(t, validatePrivateCA(crt, otherKey))
+
+	// Certificate is not a CA
+	caCert, err := tls.ParsePemCertificate(crt)
+	require.NoError(t, err)
+	caKey, err := tls.ParsePemKey(key)
+	require.NoError(t, err)
+	leafDER, leafKey, err := tls.GenerateCASignedCert(caCert, caKey)
+	require.NoError(t, err)
+	leafCrt, leafKeyPEM, err := tls.EncodeCertAndKeyToPEM(leafDER, leafKey)
+	require.NoError(t, err)
+	assert.Error(t, validatePrivateCA(leafCrt, leafKeyPEM))
+
+	// Invalid PEM
+	assert.Error(t, validatePrivateCA([]byte("not a certificate"), key))
+	assert.Error(t, validatePrivateCA(crt, []byte("not a key")))
+}
+
+func newTestCASecret(t *testing.T) corev1.Secret {
+	publicCrt, publicKey := tls.GetChiaCACertAndKey()
+	privateCrt, privateKey, err := generatePrivateCA()
+	require.NoError(t, err)
+	return corev1.Secret{
+		Data: map[string][]byte{
+			chiaCACrtKey:    publicCrt,
+			chiaCAKeyKey:    publicKey,
+			privateCACrtKey: privateCrt,
+			privateCAKeyKey: privateKey,
+		},
+	}
+}
+
+func TestValidateCASecret(t *testing.T) {
+	secret := newTestCASecret(t)
+	require.NoError(t, validateCASecret(secret))
+
+	// Missing private CA key
+	missing := newTestCASecret(t)
+	delete(missing.Data, privateCAKeyKey)
+	err := validateCASecret(missing)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private_ca.key is missing")
+
+	// Private CA key from a different CA
+	_, otherKey, err := generatePrivateCA()
+	require.NoError(t, err)
+	mismatched := newTestCASecret(t)
+	mismatched.Data[privateCAKeyKey] = otherKey
+	err = validateCASecret(mismatched)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "key does not match the certificate")
+
+	// Truncated public CA certificate
+	truncated := newTestCASecret(t)
+	truncated.Data[chiaCACrtKey] = truncated.Data[chiaCACrtKey][:100]
+	err = validateCASecret(truncated)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chia_ca.crt")
+}
+
+func TestRepairCASecret(t *testing.T) {
+	secret := newTestCASecret(t)
+	originalPrivateCrt := secret.Data[privateCACrtKey]
+	delete(secret.Data, chiaCAKeyKey)
+
+	// Only the public CA is invalid, so the private CA is kept
+	r := &ChiaCAReconciler{}
+	found, err := r.repairCASecret(context.TODO(), testChiaCA, &secret)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, validateCASecret(secret))
+	assert.Equal(t, originalPrivateCrt, secret.Data[privateCACrtKey])
+
+	// An invalid private CA is regenerated
+	secret.Data[privateCAKeyKey] = []byte("tampered")
+	found, err = r.repairCASecret(context.TODO(), testChiaCA, &secret)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, validateCASecret(secret))
+	assert.NotEqual(t, originalPrivateCrt, secret.Data[privateCACrtKey])
+}
+
+func TestAssembleCACertificate(t *testing.T) {
+	cert := assembleCACertificate(testChiaCA)
+	secretName, _, err := unstructured.NestedString(cert.Object, "spec", "secretName")
+	require.NoError(t, err)
+	assert.Equal(t, "testname-private-ca", secretName)
+	issuerName, _, err := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "testname-selfsigned", issuerName)
+
+	ca := testChiaCA
+	ca.Spec.IssuerRef = &k8schianetv1.CertManagerIssuerRef{Name: "org-ca", Kind: "ClusterIssuer"}
+	cert = assembleCACertificate(ca)
+	issuerRef, _, err := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "org-ca", "kind": "ClusterIssuer", "group": "cert-manager.io"}, issuerRef)
+}
@@ -5,18 +5,32 @@ Copyright 2023 Chia Network Inc.
 package controller
 
 import (
+	"bytes"
 	"context"
 	"time"
 
+	"github.com/chia-network/go-chia-libs/pkg/tls"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
 	apiv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 )
 
+// generateTestCA returns the PEM encoded certificate and key for a new private CA
+func generateTestCA() ([]byte, []byte) {
+	caDER, caKey, err := tls.GenerateNewCA()
+	Expect(err).NotTo(HaveOccurred())
+	crt, key, err := tls.EncodeCertAndKeyToPEM(caDER, caKey)
+	Expect(err).NotTo(HaveOccurred())
+	return crt, key
+}
+
 var _ = Describe("ChiaCA controller", func() {
 	var (
 		timeout  = time.Second * 10
@@ -61,4 +75,78 @@ var _ = Describe("ChiaCA controller", func() {
 			Expect(createdChiaCA.Spec).Should(Equal(expect.Spec))
 		})
 	})
+
+	Context("When creating ChiaCA with the CertManager backend", func() {
+		It("should copy the issued private CA, and rotate to a renewed one in stages", func() {
+			By("By creating a new ChiaCA")
+			ctx := context.Background()
+			testCA := &apiv1.ChiaCA{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "k8s.chia.net/v1",
+					Kind:       "ChiaCA",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-chiaca-certmanager",
+					Namespace: "default",
+				},
+				Spec: apiv1.ChiaCASpec{
+					Secret:  "test-certmanager-ca",
+					Backend: apiv1.CertificateBackendCertManager,
+				},
+			}
+			Expect(k8sClient.Create(ctx, testCA)).Should(Succeed())
+
+			// The operator creates a Certificate for cert-manager to issue the private CA from
+			cert := &unstructured.Unstructured{}
+			cert.SetGroupVersionKind(kube.CertManagerCertificateGVK)
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-chiaca-certmanager-private-ca", Namespace: "default"}, cert)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+
+			By("By issuing the private CA like cert-manager would")
+			oldCrt, oldKey := generateTestCA()
+			issued := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-certmanager-ca-private-ca",
+					Namespace: "default",
+				},
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       oldCrt,
+					corev1.TLSPrivateKeyKey: oldKey,
+				},
+			}
+			Expect(k8sClient.Create(ctx, issued)).Should(Succeed())
+
+			// The issued private CA is copied into the CA Secret
+			caSecret := &corev1.Secret{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-certmanager-ca", Namespace: "default"}, caSecret)
+				return err == nil && bytes.Equal(caSecret.Data["private_ca.crt"], oldCrt)
+			}, timeout, interval).Should(BeTrue())
+			Expect(caSecret.Data["private_ca.key"]).Should(Equal(oldKey))
+
+			By("By renewing the private CA like cert-manager would")
+			newCrt, newKey := generateTestCA()
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: issued.Name, Namespace: issued.Namespace}, issued)).Should(Succeed())
+			issued.Data[corev1.TLSCertKey] = newCrt
+			issued.Data[corev1.TLSPrivateKeyKey] = newKey
+			Expect(k8sClient.Update(ctx, issued)).Should(Succeed())
+
+			// The renewed private CA isn't swapped in directly, a rotation starts by trusting both CAs
+			lookupKey := types.NamespacedName{Name: testCA.Name, Namespace: testCA.Namespace}
+			createdChiaCA := &apiv1.ChiaCA{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, createdChiaCA)
+				return err == nil && createdChiaCA.Status.Rotation != nil && createdChiaCA.Status.Rotation.Stage == apiv1.ChiaCARotationStageTrustBundle
+			}, timeout, interval).Should(BeTrue())
+			Expect(createdChiaCA.Status.Rotation.TargetRevision).ShouldNot(Equal(createdChiaCA.Status.Revision))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-certmanager-ca", Namespace: "default"}, caSecret)).Should(Succeed())
+			Expect(caSecret.Data["private_ca.crt"]).Should(Equal(append(append([]byte{}, oldCrt...), newCrt...)))
+			Expect(caSecret.Data["private_ca.key"]).Should(Equal(oldKey))
+			Expect(caSecret.Data["next_private_ca.crt"]).Should(Equal(newCrt))
+		})
+	})
 })
@@ -6,10 +6,13 @@ package chiacertificates
 
 import (
 	"strings"
+	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func assembleSecret(cr k8schianetv1.ChiaCertificates, certMap map[string]string) corev1.Secret {
@@ -28,3 +31,39 @@ func assembleSecret(cr k8schianetv1.ChiaCertificates, certMap map[string]string)
 		StringData: certMap,
 	}
 }
+
+// assembleIssuerCASecret assembles a kubernetes.io/tls Secret containing a CA for a cert-manager CA Issuer
+func assembleIssuerCASecret(cr k8schianetv1.ChiaCertificates, name string, crt, key []byte) corev1.Secret {
+	return corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cr.Namespace,
+			Labels:    kube.GetCommonLabels(cr.Kind, cr.ObjectMeta),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       crt,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	}
+}
+
+// assembleCAIssuer assembles a cert-manager Issuer that signs certificates with the CA in the Secret of the same name
+func assembleCAIssuer(cr k8schianetv1.ChiaCertificates, name string) unstructured.Unstructured {
+	return kube.AssembleCertManagerCAIssuer(name, cr.Namespace, kube.GetCommonLabels(cr.Kind, cr.ObjectMeta), name)
+}
+
+// assembleServiceCertificate assembles the cert-manager Certificate for a Chia service's certificate-key pair.
+// Private certificates are issued by the private CA Issuer, public certificates by the public Chia CA Issuer.
+func assembleServiceCertificate(cr k8schianetv1.ChiaCertificates, service string) unstructured.Unstructured {
+	issuerName := getIssuerName(cr, getServiceCAType(service))
+
+	var renewBefore *time.Duration
+	if cr.Spec.RenewBefore != nil && cr.Spec.RenewBefore.Duration > 0 {
+		renewBefore = &cr.Spec.RenewBefore.Duration
+	}
+
+	name := getServiceSecretName(cr, service)
+	return kube.AssembleCertManagerChiaCertificate(name, cr.Namespace, kube.GetCommonLabels(cr.Kind, cr.ObjectMeta), name,
+		k8schianetv1.CertManagerIssuerRef{Name: issuerName}, renewBefore)
+}
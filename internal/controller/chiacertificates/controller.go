@@ -8,21 +8,27 @@ import (
 	"context"
 	stdlibErrors "errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/chia-network/go-chia-libs/pkg/tls"
-
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // ChiaCertificatesReconciler reconciles a ChiaCertificates object
@@ -30,18 +36,23 @@ type ChiaCertificatesReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiacertificates = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaCertificates resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacertificates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacertificates/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacertificates/finalizers,verbs=update
-//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaCertificatesReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaCertificatesKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -49,12 +60,6 @@ func (r *ChiaCertificatesReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	var cr k8schianetv1.ChiaCertificates
 	err := r.Get(ctx, req.NamespacedName, &cr)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiacertificates[req.String()]
-		if exists {
-			delete(chiacertificates, req.String())
-			metrics.ChiaCertificates.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -62,82 +67,286 @@ func (r *ChiaCertificatesReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiacertificates[req.String()]
-	if !exists {
-		chiacertificates[req.String()] = true
-		metrics.ChiaCertificates.Add(1.0)
-	}
-
-	// Verify that certificate Secret name does not match the CA Secret name
+	// Verify that certificate Secret name does not match the CA Secret name.
+	// The validating webhook rejects this when it's enabled, so this only catches resources it didn't see.
 	certSecretName := getChiaCertificatesSecretName(cr)
 	caSecretName := cr.Spec.CASecretName
 	if certSecretName == caSecretName {
 		log.Error(stdlibErrors.New("certificate Secret cannot be the same name as the CA Secret"),
 			"Invalid certificate Secret name", "certificate Secret name", certSecretName, "CA Secret name", caSecretName)
+		r.Recorder.Event(&cr, corev1.EventTypeWarning, "Failed",
+			fmt.Sprintf("Certificate Secret %q cannot have the same name as the CA Secret -- Set a different spec.secret.", certSecretName))
 		return ctrl.Result{}, nil
 	}
 
 	// Check if certificate Secret exists
-	_, certSecretExists, err := r.getSecret(ctx, cr.Namespace, certSecretName)
+	certSecret, certSecretExists, err := r.getSecret(ctx, cr.Namespace, certSecretName)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("encountered error querying for existing Certificates Secret: %v", err)
 	}
 
-	// If Certificates Secret doesn't exist, generate certificates and create one
-	if !certSecretExists {
-		caSecret, caSecretExists, err := r.getSecret(ctx, cr.Namespace, caSecretName)
-		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("encountered error querying for existing CA Secret: %v", err)
+	// Check if CA Secret exists
+	caSecret, caSecretExists, err := r.getSecret(ctx, cr.Namespace, caSecretName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("encountered error querying for existing CA Secret: %v", err)
+	}
+
+	// cert-manager issues and renews the certificates itself, they're only assembled into the Certificates Secret
+	if cr.Spec.Backend == k8schianetv1.CertificateBackendCertManager {
+		return r.reconcileCertManager(ctx, &cr, certSecret, certSecretExists, caSecret, caSecretExists)
+	}
+
+	// If the Certificates Secret exists, determine if it is invalid or its certificates are due for renewal
+	renewBefore := getRenewBefore(cr)
+	services := getServices(cr)
+	needsRenewal := false
+	renewalDue := false
+	if certSecretExists {
+		notAfter, err := getEarliestNotAfter(certSecret)
+		if !secretMatchesServices(certSecret, services) {
+			log.Info("Certificates Secret does not contain the configured services, regenerating")
+			needsRenewal = true
+		} else if problem := validateCertSecret(certSecret, caSecret, services); problem != nil {
+			if cr.Spec.RepairPolicy != k8schianetv1.SecretRepairPolicyRegenerate {
+				log.Info("Certificates Secret is invalid", "problem", problem.Error())
+				return r.markDegraded(ctx, &cr, "InvalidSecret", problem)
+			}
+			log.Info("Certificates Secret is invalid, regenerating", "problem", problem.Error())
+			r.Recorder.Event(&cr, corev1.EventTypeNormal, "Repairing",
+				fmt.Sprintf("Regenerating invalid Certificates Secret %s/%s: %v", cr.Namespace, certSecretName, problem))
+			needsRenewal = true
+		} else if err != nil {
+			log.Error(err, "unable to read certificate expiration from Certificates Secret, certificates will be regenerated")
+			needsRenewal = true
+		} else if !time.Now().Before(notAfter.Add(-renewBefore)) {
+			log.Info("Certificates are within their renewal window, regenerating", "notAfter", notAfter.String())
+			needsRenewal = true
+			renewalDue = true
+		} else if caSecretExists && !signedByCA(certSecret, caSecret) {
+			log.Info("Certificates were not signed by the current private CA, regenerating")
+			needsRenewal = true
 		}
+	}
+
+	// If Certificates Secret doesn't exist or needs renewal, generate certificates and create or update it
+	if !certSecretExists || needsRenewal {
 		if !caSecretExists {
 			log.Info("CA Secret not found, cancelling reconciliation and retrying in 10 seconds")
-			return ctrl.Result{
-				RequeueAfter: 10 * time.Second,
-			}, nil
+			return r.markProgressing(ctx, &cr, "WaitingForCASecret", fmt.Sprintf("Waiting for CA Secret %s", caSecretName))
+		}
+
+		certMap, err := generateCertMap(caSecret, services)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		secret := assembleSecret(cr, certMap)
+
+		// A renewal that doesn't move the expiration out of the renewal window would regenerate the certificates
+		// and restart their consumers on every reconcile, which happens when renewBefore is longer than the certificates' lifetime
+		if renewalDue {
+			renewedNotAfter, err := getEarliestNotAfter(secret)
+			if err == nil && !time.Now().Before(renewedNotAfter.Add(-renewBefore)) {
+				log.Info("Renewed certificates would still be within their renewal window, not renewing", "notAfter", renewedNotAfter.String())
+				return r.markDegraded(ctx, &cr, "RenewBeforeTooLong",
+					fmt.Errorf("renewBefore %s is longer than the lifetime of renewed certificates, which expire at %s", renewBefore, renewedNotAfter.Format(time.RFC3339)))
+			}
 		}
 
-		privateCACertData, ok := caSecret.Data["private_ca.crt"]
-		if !ok {
-			return ctrl.Result{}, fmt.Errorf("private CA certificate not present in CA Secret")
+		if !certSecretExists {
+			if err = r.Create(ctx, &secret); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error creating certificate Secret \"%s\": %v", secret.Name, err)
+			}
+		} else {
+			certSecret.Labels = kube.CombineMaps(certSecret.Labels, secret.Labels)
+			certSecret.Data = nil
+			certSecret.StringData = secret.StringData
+			if err = r.Update(ctx, &certSecret); err != nil {
+				if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+				}
+				return ctrl.Result{}, fmt.Errorf("error updating certificate Secret \"%s\": %v", secret.Name, err)
+			}
+
+			if err = r.recordRenewal(ctx, &cr); err != nil {
+				return ctrl.Result{}, err
+			}
+			r.Recorder.Event(&cr, corev1.EventTypeNormal, "Renewed",
+				fmt.Sprintf("Successfully renewed certificates in Secret %s/%s", cr.Namespace, certSecretName))
 		}
-		privateCACert, err := tls.ParsePemCertificate(privateCACertData)
+		certSecret = secret
+	}
+
+	if err = r.restartSecretConsumers(ctx, &cr, certSecretName); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	notAfter, err := getEarliestNotAfter(certSecret)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("encountered error reading certificate expiration: %v", err)
+	}
+	renewalTime := notAfter.Add(-renewBefore)
+
+	if !cr.Status.Ready {
+		r.Recorder.Event(&cr, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Successfully created Certificates Secret in %s/%s", cr.Namespace, cr.Name))
+	}
+
+	conditionsChanged := kube.SetReadyConditions(&cr.Status.Conditions, cr.Generation, "SecretValid", "Certificates Secret contains valid certificates")
+	statusChanged := conditionsChanged || cr.Status.ObservedGeneration != cr.Generation || cr.Status.NotAfter == nil || cr.Status.NotAfter.Unix() != notAfter.Unix() ||
+		cr.Status.RenewalTime == nil || cr.Status.RenewalTime.Unix() != renewalTime.Unix()
+	if !cr.Status.Ready || statusChanged || needsRenewal {
+		cr.Status.Ready = true
+		cr.Status.ObservedGeneration = cr.Generation
+		cr.Status.NotAfter = &metav1.Time{Time: notAfter}
+		cr.Status.RenewalTime = &metav1.Time{Time: renewalTime}
+		err = r.Status().Update(ctx, &cr)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("error parsing private CA certificate from Secret: %v", err)
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			log.Error(err, "encountered error updating ChiaCertificates status")
+			return ctrl.Result{}, err
 		}
+	}
+
+	// Requeue for when the certificates should be renewed
+	return ctrl.Result{RequeueAfter: time.Until(renewalTime)}, nil
+}
+
+// reconcileCertManager creates cert-manager Issuers for the CAs in the CA Secret and a cert-manager Certificate for each service,
+// then assembles the issued certificates into the Certificates Secret
+func (r *ChiaCertificatesReconciler) reconcileCertManager(ctx context.Context, cr *k8schianetv1.ChiaCertificates, certSecret corev1.Secret, certSecretExists bool, caSecret corev1.Secret, caSecretExists bool) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	services := getServices(*cr)
+
+	if !caSecretExists {
+		log.Info("CA Secret not found, cancelling reconciliation and retrying in 10 seconds")
+		return r.markProgressing(ctx, cr, "WaitingForCASecret", fmt.Sprintf("Waiting for CA Secret %s", cr.Spec.CASecretName))
+	}
 
-		privateCAKeyData, ok := caSecret.Data["private_ca.key"]
-		if !ok {
-			return ctrl.Result{}, fmt.Errorf("private CA key not present in CA Secret")
+	// Reconcile a CA Secret and Issuer for the private and public CAs
+	issuerCAs, err := getIssuerCAs(caSecret)
+	if err != nil {
+		return r.markDegraded(ctx, cr, "InvalidSecret", err)
+	}
+	for _, ca := range []caType{privateCA, publicCA} {
+		issuerSecret := assembleIssuerCASecret(*cr, getIssuerName(*cr, ca), issuerCAs[ca][0], issuerCAs[ca][1])
+		if err := controllerutil.SetControllerReference(cr, &issuerSecret, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error assembling %s Issuer Secret: %v", ca, err)
 		}
-		privateCAKey, err := tls.ParsePemKey(privateCAKeyData)
+		res, err := kube.ReconcileSecret(ctx, r.Client, issuerSecret)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("error parsing private CA key from Secret: %v", err)
+			r.Recorder.Event(cr, corev1.EventTypeWarning, "Failed", "Failed to create cert-manager Issuer Secret -- Check operator logs.")
+			return res, err
 		}
 
-		allCerts, err := tls.GenerateAllCerts(privateCACert, privateCAKey)
+		issuer := assembleCAIssuer(*cr, getIssuerName(*cr, ca))
+		if err := controllerutil.SetControllerReference(cr, &issuer, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error assembling %s Issuer: %v", ca, err)
+		}
+		res, err = kube.ReconcileCertManagerObject(ctx, r.Client, issuer)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("error generating new certificates: %v", err)
+			r.Recorder.Event(cr, corev1.EventTypeWarning, "Failed", "Failed to create cert-manager Issuer -- Check operator logs.")
+			return res, err
 		}
+	}
 
-		certMap, err := constructCertMap(allCerts)
+	// Reconcile a Certificate for each service, and collect the Secrets cert-manager issued
+	issued := make(map[string]corev1.Secret)
+	for _, service := range services {
+		cert := assembleServiceCertificate(*cr, service)
+		if err := controllerutil.SetControllerReference(cr, &cert, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error assembling %s Certificate: %v", service, err)
+		}
+		res, err := kube.ReconcileCertManagerObject(ctx, r.Client, cert)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("error converting certificates to map: %v", err)
+			r.Recorder.Event(cr, corev1.EventTypeWarning, "Failed", "Failed to create cert-manager Certificate -- Check operator logs.")
+			return res, err
 		}
 
-		secret := assembleSecret(cr, certMap)
+		secret, exists, err := r.getSecret(ctx, cr.Namespace, getServiceSecretName(*cr, service))
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error querying for cert-manager issued Secret: %v", err)
+		}
+		if exists && len(secret.Data[corev1.TLSCertKey]) > 0 && !issuedByCA(secret, issuerCAs[getServiceCAType(service)][0]) {
+			// The issuer's CA changed since this certificate was issued, and cert-manager re-issues a certificate when its Secret is deleted
+			if err := r.Delete(ctx, &secret); client.IgnoreNotFound(err) != nil {
+				return ctrl.Result{}, fmt.Errorf("encountered error deleting %s issued Secret to re-issue it: %v", service, err)
+			}
+			log.Info("Deleted certificate issued by a previous CA so cert-manager re-issues it", "service", service)
+			continue
+		}
+		if exists {
+			issued[secret.Name] = secret
+		}
+	}
+
+	// Remove Certificates and issued Secrets for services that are no longer requested
+	for name := range certNodes {
+		if slices.Contains(services, name) {
+			continue
+		}
+		cert := assembleServiceCertificate(*cr, name)
+		if err := r.Delete(ctx, &cert); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error deleting %s Certificate: %v", name, err)
+		}
+		secret := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: getServiceSecretName(*cr, name), Namespace: cr.Namespace}}
+		if err := r.Delete(ctx, &secret); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("encountered error deleting %s issued Secret: %v", name, err)
+		}
+	}
+
+	certMap, ready := certMapFromIssuedSecrets(*cr, services, issued)
+	if !ready {
+		log.Info("Waiting for cert-manager to issue certificates, retrying in 10 seconds")
+		return r.markProgressing(ctx, cr, "WaitingForCertManager", "Waiting for cert-manager to issue certificates")
+	}
+
+	// Create or update the Certificates Secret with the issued certificates
+	secret := assembleSecret(*cr, certMap)
+	if !certSecretExists {
 		if err = r.Create(ctx, &secret); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error creating certificate Secret \"%s\": %v", secret.Name, err)
 		}
+		r.Recorder.Event(cr, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Successfully created Certificates Secret in %s/%s", cr.Namespace, cr.Name))
+	} else if !secretMatchesCertMap(certSecret, certMap) {
+		certSecret.Labels = kube.CombineMaps(certSecret.Labels, secret.Labels)
+		certSecret.Data = nil
+		certSecret.StringData = secret.StringData
+		if err = r.Update(ctx, &certSecret); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("error updating certificate Secret \"%s\": %v", secret.Name, err)
+		}
+
+		if err = r.recordRenewal(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(cr, corev1.EventTypeNormal, "Renewed",
+			fmt.Sprintf("Successfully updated certificates issued by cert-manager in Secret %s/%s", cr.Namespace, secret.Name))
 	}
 
-	if !cr.Status.Ready {
-		r.Recorder.Event(&cr, corev1.EventTypeNormal, "Created",
-			fmt.Sprintf("Successfully created Certificates Secret in %s/%s", cr.Namespace, cr.Name))
+	if err = r.restartSecretConsumers(ctx, cr, secret.Name); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	notAfter, err := getEarliestNotAfter(secret)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("encountered error reading certificate expiration: %v", err)
+	}
 
+	conditionsChanged := kube.SetReadyConditions(&cr.Status.Conditions, cr.Generation, "SecretValid", "Certificates Secret contains the certificates issued by cert-manager")
+	statusChanged := conditionsChanged || cr.Status.ObservedGeneration != cr.Generation || cr.Status.NotAfter == nil || cr.Status.NotAfter.Unix() != notAfter.Unix() || cr.Status.RenewalTime != nil
+	if !cr.Status.Ready || statusChanged {
 		cr.Status.Ready = true
-		err = r.Status().Update(ctx, &cr)
-		if err != nil {
+		cr.Status.ObservedGeneration = cr.Generation
+		cr.Status.NotAfter = &metav1.Time{Time: notAfter}
+		// cert-manager decides when to renew the certificates
+		cr.Status.RenewalTime = nil
+		if err = r.Status().Update(ctx, cr); err != nil {
 			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
 				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
 			}
@@ -149,9 +358,107 @@ func (r *ChiaCertificatesReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	return ctrl.Result{}, nil
 }
 
+// recordRenewal sets the ChiaCertificates' lastRenewalTime and saves it right away, before the workloads mounting the Secret are restarted.
+// The status is patched rather than updated, so the renewal can't be lost to a conflict after the Secret has already been updated.
+func (r *ChiaCertificatesReconciler) recordRenewal(ctx context.Context, cr *k8schianetv1.ChiaCertificates) error {
+	patch := client.MergeFrom(cr.DeepCopy())
+	cr.Status.LastRenewalTime = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Patch(ctx, cr, patch); err != nil {
+		return fmt.Errorf("encountered error recording certificate renewal in ChiaCertificates status: %v", err)
+	}
+	return nil
+}
+
+// restartSecretConsumers rolls out the workloads that mount the certificates Secret and haven't been restarted since the certificates were last renewed.
+// This runs on every reconcile, so a restart that failed after the Secret was updated is retried until it succeeds.
+func (r *ChiaCertificatesReconciler) restartSecretConsumers(ctx context.Context, cr *k8schianetv1.ChiaCertificates, secretName string) error {
+	if cr.Status.LastRenewalTime == nil {
+		return nil
+	}
+	if err := kube.RestartSecretConsumers(ctx, r.Client, cr.Namespace, secretName, cr.Status.LastRenewalTime.Time); err != nil {
+		r.Recorder.Event(cr, corev1.EventTypeWarning, "Failed", "Failed to restart workloads mounting renewed certificates -- Check operator logs.")
+		return fmt.Errorf("encountered error restarting workloads mounting Certificates Secret: %v", err)
+	}
+	return nil
+}
+
+// markDegraded sets the ChiaCertificates' Degraded condition to explain why its certificates Secret is invalid or can't be renewed
+func (r *ChiaCertificatesReconciler) markDegraded(ctx context.Context, cr *k8schianetv1.ChiaCertificates, reason string, problem error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaCertificatesKind), "secret")
+	changed := kube.SetCondition(&cr.Status.Conditions, cr.Generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionTrue, reason, problem.Error())
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Certificates Secret is invalid: %v", problem)
+	if reason == "RenewBeforeTooLong" {
+		message = fmt.Sprintf("Certificates can't be renewed: %v", problem)
+	}
+	r.Recorder.Event(cr, corev1.EventTypeWarning, "Degraded", message)
+	cr.Status.ObservedGeneration = cr.Generation
+	if err := r.Status().Update(ctx, cr); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		log.FromContext(ctx).Error(err, "encountered error updating ChiaCertificates status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// markProgressing sets the ChiaCertificates' Progressing condition while it waits on something before the certificates Secret can be created, and requeues to check again
+func (r *ChiaCertificatesReconciler) markProgressing(ctx context.Context, cr *k8schianetv1.ChiaCertificates, reason, message string) (ctrl.Result, error) {
+	if kube.SetProgressingConditions(&cr.Status.Conditions, cr.Generation, reason, message) {
+		cr.Status.ObservedGeneration = cr.Generation
+		if err := r.Status().Update(ctx, cr); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			log.FromContext(ctx).Error(err, "encountered error updating ChiaCertificates status")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaCertificatesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaCertificates by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaCertificates{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaCertificates))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8schianetv1.ChiaCertificates{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
+		).
 		Complete(r)
 }
+
+// handleSecrets enqueues the ChiaCertificates that use a Secret as their CA or certificates Secret,
+// so certificates are re-issued when the private CA changes and validated when the certificates Secret changes.
+// With the CertManager backend, this also enqueues the ChiaCertificates when cert-manager issues a certificate.
+func (r *ChiaCertificatesReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &k8schianetv1.ChiaCertificatesList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
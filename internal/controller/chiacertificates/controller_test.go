@@ -0,0 +1,199 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiacertificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	"github.com/chia-network/go-chia-libs/pkg/tls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testScheme returns a scheme with the built-in and Chia kinds
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, k8schianetv1.AddToScheme(scheme))
+	return scheme
+}
+
+// testCASecret returns a CA Secret with a newly generated private CA
+func testCASecret(t *testing.T) corev1.Secret {
+	caDER, caKey, err := tls.GenerateNewCA()
+	require.NoError(t, err)
+	caCrt, caKeyPEM, err := tls.EncodeCertAndKeyToPEM(caDER, caKey)
+	require.NoError(t, err)
+	return corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: "default"},
+		Data: map[string][]byte{
+			"private_ca.crt": caCrt,
+			"private_ca.key": caKeyPEM,
+		},
+	}
+}
+
+func TestReconcile_RetriesPendingRestarts(t *testing.T) {
+	scheme := testScheme(t)
+
+	caSecret := testCASecret(t)
+
+	cr := k8schianetv1.ChiaCertificates{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Spec:       k8schianetv1.ChiaCertificatesSpec{CASecretName: caSecret.Name},
+	}
+	certMap, err := generateCertMap(caSecret, getServices(cr))
+	require.NoError(t, err)
+	certSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range certMap {
+		certSecret.Data[k] = []byte(v)
+	}
+
+	// The certificates were renewed, but restarting the workload that mounts them failed
+	renewedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	cr.Status.LastRenewalTime = &metav1.Time{Time: renewedAt}
+	deploy := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "farmer", Namespace: "default", CreationTimestamp: metav1.NewTime(renewedAt.Add(-time.Hour))},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{{
+						Name:         "certs",
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: certSecret.Name}},
+					}},
+				},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(&cr, &caSecret, &certSecret, &deploy).
+		WithStatusSubresource(&k8schianetv1.ChiaCertificates{}).
+		Build()
+	r := &ChiaCertificatesReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&cr)})
+	require.NoError(t, err)
+
+	var actual appsv1.Deployment
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(&deploy), &actual))
+	assert.Equal(t, renewedAt.Format(time.RFC3339), actual.Spec.Template.Annotations[kube.RestartedAtAnnotation])
+}
+
+func TestReconcile_PublicServicesOnly(t *testing.T) {
+	scheme := testScheme(t)
+	caSecret := testCASecret(t)
+	cr := k8schianetv1.ChiaCertificates{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Spec: k8schianetv1.ChiaCertificatesSpec{
+			CASecretName: caSecret.Name,
+			Services:     []string{"public_full_node", "public_wallet"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(&cr, &caSecret).
+		WithStatusSubresource(&k8schianetv1.ChiaCertificates{}).
+		Build()
+	r := &ChiaCertificatesReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&cr)}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	var created corev1.Secret
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "certs"}, &created))
+	require.Len(t, created.StringData, 4)
+
+	// The fake client doesn't merge stringData into data like the API server does
+	created.Data = make(map[string][]byte)
+	for k, v := range created.StringData {
+		created.Data[k] = []byte(v)
+	}
+	created.StringData = nil
+	require.NoError(t, c.Update(ctx, &created))
+
+	// The public certificates' expiration is tracked, so they aren't regenerated on the next reconcile
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	var actual corev1.Secret
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "certs"}, &actual))
+	assert.Equal(t, created.ResourceVersion, actual.ResourceVersion)
+
+	var status k8schianetv1.ChiaCertificates
+	require.NoError(t, c.Get(ctx, req.NamespacedName, &status))
+	assert.True(t, status.Status.Ready)
+	assert.NotNil(t, status.Status.NotAfter)
+	assert.Nil(t, status.Status.LastRenewalTime)
+}
+
+func TestReconcile_RenewBeforeLongerThanLifetime(t *testing.T) {
+	scheme := testScheme(t)
+	caSecret := testCASecret(t)
+
+	// The operator generates certificates that expire in 2100, so a renewal can never move their expiration past this window
+	cr := k8schianetv1.ChiaCertificates{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Spec: k8schianetv1.ChiaCertificatesSpec{
+			CASecretName: caSecret.Name,
+			RenewBefore:  &metav1.Duration{Duration: 200 * 365 * 24 * time.Hour},
+		},
+	}
+	certMap, err := generateCertMap(caSecret, getServices(cr))
+	require.NoError(t, err)
+	certSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range certMap {
+		certSecret.Data[k] = []byte(v)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(&cr, &caSecret, &certSecret).
+		WithStatusSubresource(&k8schianetv1.ChiaCertificates{}).
+		Build()
+	r := &ChiaCertificatesReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&cr)}
+
+	var before corev1.Secret
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&certSecret), &before))
+
+	// The certificates aren't regenerated, and the resource is marked Degraded instead
+	for range 2 {
+		res, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Zero(t, res.RequeueAfter)
+	}
+	var actual corev1.Secret
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&certSecret), &actual))
+	assert.Equal(t, before.ResourceVersion, actual.ResourceVersion)
+
+	var status k8schianetv1.ChiaCertificates
+	require.NoError(t, c.Get(ctx, req.NamespacedName, &status))
+	degraded := meta.FindStatusCondition(status.Status.Conditions, k8schianetv1.ConditionTypeDegraded)
+	require.NotNil(t, degraded)
+	assert.Equal(t, metav1.ConditionTrue, degraded.Status)
+	assert.Equal(t, "RenewBeforeTooLong", degraded.Reason)
+	assert.Nil(t, status.Status.LastRenewalTime)
+}
@@ -6,10 +6,15 @@ package chiacertificates
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 
 	"github.com/chia-network/go-chia-libs/pkg/tls"
 	corev1 "k8s.io/api/core/v1"
@@ -17,6 +22,20 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// defaultRenewBefore is the default window before the earliest certificate expiration in which certificates are renewed
+const defaultRenewBefore = 30 * 24 * time.Hour
+
+// caType is the type of CA that signs a Chia certificate
+type caType string
+
+const (
+	// privateCA signs the private_ certificates
+	privateCA caType = "private-ca"
+
+	// publicCA is the public Chia CA, which signs the public_ certificates
+	publicCA caType = "public-ca"
+)
+
 // getSecret fetches the k8s Secret that matches this ChiaCertificates deployment. Returns true if the Secret exists.
 func (r *ChiaCertificatesReconciler) getSecret(ctx context.Context, namespace, name string) (corev1.Secret, bool, error) {
 	var secret corev1.Secret
@@ -33,6 +52,108 @@ func (r *ChiaCertificatesReconciler) getSecret(ctx context.Context, namespace, n
 	return secret, true, nil
 }
 
+// getIssuerName gets the name of the cert-manager CA Issuer for a type of CA, when using the CertManager backend. This is also the name of the Issuer's CA Secret.
+func getIssuerName(cr k8schianetv1.ChiaCertificates, ca caType) string {
+	return kube.CertManagerObjectName(getChiaCertificatesSecretName(cr), string(ca))
+}
+
+// getServiceSecretName gets the name of the cert-manager Certificate for a service, and the Secret it's issued into, when using the CertManager backend
+func getServiceSecretName(cr k8schianetv1.ChiaCertificates, service string) string {
+	return kube.CertManagerObjectName(getChiaCertificatesSecretName(cr), service)
+}
+
+// secretRefs returns the names of the Secrets a ChiaCertificates uses, which are its CA and certificates Secrets.
+// With the CertManager backend, this includes the Issuer CA Secrets and the Secrets cert-manager issues each service's certificate into.
+func secretRefs(cr k8schianetv1.ChiaCertificates) []string {
+	refs := []string{getChiaCertificatesSecretName(cr)}
+	if cr.Spec.CASecretName != "" {
+		refs = append(refs, cr.Spec.CASecretName)
+	}
+	if cr.Spec.Backend == k8schianetv1.CertificateBackendCertManager {
+		refs = append(refs, getIssuerName(cr, privateCA), getIssuerName(cr, publicCA))
+		for _, service := range getServices(cr) {
+			refs = append(refs, getServiceSecretName(cr, service))
+		}
+	}
+	return refs
+}
+
+// getServiceCAType returns the type of CA that signs a service's certificate
+func getServiceCAType(service string) caType {
+	if strings.HasPrefix(service, "private_") {
+		return privateCA
+	}
+	return publicCA
+}
+
+// issuedByCA returns true if the certificate cert-manager issued into the Secret is signed by the PEM encoded CA certificate
+func issuedByCA(secret corev1.Secret, caCrt []byte) bool {
+	ca, err := tls.ParsePemCertificate(caCrt)
+	if err != nil {
+		return false
+	}
+	cert, err := tls.ParsePemCertificate(kube.FirstPEMBlock(secret.Data[corev1.TLSCertKey]))
+	if err != nil {
+		return false
+	}
+	return cert.CheckSignatureFrom(ca) == nil
+}
+
+// getIssuerCAs returns the PEM encoded certificate and key for each type of CA from the CA Secret, for cert-manager Issuers.
+// The public Chia CA falls back to the one in go-chia-libs if the CA Secret doesn't contain it.
+func getIssuerCAs(caSecret corev1.Secret) (map[caType][2][]byte, error) {
+	privateCrt := kube.FirstPEMBlock(caSecret.Data["private_ca.crt"])
+	privateKey := caSecret.Data["private_ca.key"]
+	if privateCrt == nil || len(privateKey) == 0 {
+		return nil, fmt.Errorf("private CA certificate and key not present in CA Secret")
+	}
+
+	publicCrt := kube.FirstPEMBlock(caSecret.Data["chia_ca.crt"])
+	publicKey := caSecret.Data["chia_ca.key"]
+	if publicCrt == nil || len(publicKey) == 0 {
+		publicCrt, publicKey = tls.GetChiaCACertAndKey()
+	}
+
+	return map[caType][2][]byte{
+		privateCA: {privateCrt, privateKey},
+		publicCA:  {publicCrt, publicKey},
+	}, nil
+}
+
+// certMapFromIssuedSecrets assembles the certificate-key pairs cert-manager issued into the layout constructCertMap produces.
+// Returns false if cert-manager hasn't issued every service's certificate yet.
+func certMapFromIssuedSecrets(cr k8schianetv1.ChiaCertificates, services []string, issued map[string]corev1.Secret) (map[string]string, bool) {
+	certMap := make(map[string]string)
+	for _, service := range services {
+		secret, ok := issued[getServiceSecretName(cr, service)]
+		if !ok {
+			return nil, false
+		}
+		crt := secret.Data[corev1.TLSCertKey]
+		key := secret.Data[corev1.TLSPrivateKeyKey]
+		if len(crt) == 0 || len(key) == 0 {
+			return nil, false
+		}
+		// Only the leaf certificate is used, Chia loads the CA from the CA directory
+		certMap[service+".crt"] = string(kube.FirstPEMBlock(crt))
+		certMap[service+".key"] = string(key)
+	}
+	return certMap, true
+}
+
+// secretMatchesCertMap returns true if the Secret's data is exactly the certificate map
+func secretMatchesCertMap(secret corev1.Secret, certMap map[string]string) bool {
+	if len(secret.Data) != len(certMap) {
+		return false
+	}
+	for k, v := range certMap {
+		if string(secret.Data[k]) != v {
+			return false
+		}
+	}
+	return true
+}
+
 // getChiaCertificatesSecretName gets the corresponding name to this resource's Secret
 func getChiaCertificatesSecretName(cr k8schianetv1.ChiaCertificates) string {
 	secretName := cr.Name
@@ -61,10 +182,54 @@ var certNodes = map[string]fetchCertKeyPair{
 	"public_wallet":      func(c *tls.ChiaCertificates) *tls.CertificateKeyPair { return c.PublicWallet },
 }
 
-func constructCertMap(allCerts *tls.ChiaCertificates) (map[string]string, error) {
+// getServices returns the names of the certificate-key pairs the ChiaCertificates' Secret should contain, which is every pair in certNodes unless the spec limits them
+func getServices(cr k8schianetv1.ChiaCertificates) []string {
+	if len(cr.Spec.Services) > 0 {
+		return cr.Spec.Services
+	}
+
+	var services []string
+	for name := range certNodes {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// secretMatchesServices returns true if the Secret contains exactly the certificate-key pairs for the given services
+func secretMatchesServices(secret corev1.Secret, services []string) bool {
+	want := make(map[string]bool)
+	for _, service := range services {
+		want[service] = true
+	}
+
+	have := make(map[string]bool)
+	for name := range secret.Data {
+		base := strings.TrimSuffix(strings.TrimSuffix(name, ".crt"), ".key")
+		if _, ok := certNodes[base]; ok {
+			have[base] = true
+		}
+	}
+
+	if len(have) != len(want) {
+		return false
+	}
+	for service := range want {
+		if !have[service] {
+			return false
+		}
+	}
+	return true
+}
+
+func constructCertMap(allCerts *tls.ChiaCertificates, services []string) (map[string]string, error) {
 	certMap := make(map[string]string)
 
-	for filenameBase, fetchCertKeyPairFunc := range certNodes {
+	for _, filenameBase := range services {
+		fetchCertKeyPairFunc, ok := certNodes[filenameBase]
+		if !ok {
+			return nil, fmt.Errorf("unknown certificate service %s", filenameBase)
+		}
 		crtKey := fetchCertKeyPairFunc(allCerts)
 		if crtKey == nil {
 			return nil, fmt.Errorf("key pair nil, but expected data for %s", filenameBase)
@@ -84,3 +249,177 @@ func constructCertMap(allCerts *tls.ChiaCertificates) (map[string]string, error)
 
 	return certMap, nil
 }
+
+// generateCertMap generates the Chia certificate-key pairs for the given services, signed by the private CA in the CA Secret
+func generateCertMap(caSecret corev1.Secret, services []string) (map[string]string, error) {
+	privateCACertData, ok := caSecret.Data["private_ca.crt"]
+	if !ok {
+		return nil, fmt.Errorf("private CA certificate not present in CA Secret")
+	}
+	// The private CA certificate may be a trust bundle during a CA rotation, the signing CA is always first
+	privateCACert, err := tls.ParsePemCertificate(kube.FirstPEMBlock(privateCACertData))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private CA certificate from Secret: %v", err)
+	}
+
+	privateCAKeyData, ok := caSecret.Data["private_ca.key"]
+	if !ok {
+		return nil, fmt.Errorf("private CA key not present in CA Secret")
+	}
+	privateCAKey, err := tls.ParsePemKey(privateCAKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private CA key from Secret: %v", err)
+	}
+
+	allCerts, err := tls.GenerateAllCerts(privateCACert, privateCAKey)
+	if err != nil {
+		return nil, fmt.Errorf("error generating new certificates: %v", err)
+	}
+
+	certMap, err := constructCertMap(allCerts, services)
+	if err != nil {
+		return nil, fmt.Errorf("error converting certificates to map: %v", err)
+	}
+
+	return certMap, nil
+}
+
+// signedByCA returns true if every private certificate in the Secret was signed by the signing certificate in the CA Secret.
+// Returns true if the CA certificate can't be parsed, since there's nothing to re-issue against.
+func signedByCA(secret corev1.Secret, caSecret corev1.Secret) bool {
+	caCert, err := tls.ParsePemCertificate(kube.FirstPEMBlock(caSecret.Data["private_ca.crt"]))
+	if err != nil {
+		return true
+	}
+
+	for name, data := range secret.Data {
+		// Public certificates are signed by Chia's public CA, only the private certificates are signed by the private CA
+		if !strings.HasPrefix(name, "private_") || !strings.HasSuffix(name, ".crt") {
+			continue
+		}
+		cert, err := tls.ParsePemCertificate(data)
+		if err != nil || cert.CheckSignatureFrom(caCert) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// validateCertSecret checks that the Secret contains the certificate and key pair for every given service, that each key matches its certificate,
+// and that each certificate chains to its CA. Private certificates must be signed by a certificate in the CA Secret's private CA bundle,
+// public certificates must be signed by the public Chia CA. The returned error describes every problem found.
+func validateCertSecret(secret corev1.Secret, caSecret corev1.Secret, services []string) error {
+	privateCAs := parsePEMCertificates(caSecret.Data["private_ca.crt"])
+	publicCACrt, _ := tls.GetChiaCACertAndKey()
+	publicCA, err := tls.ParsePemCertificate(publicCACrt)
+	if err != nil {
+		return fmt.Errorf("error parsing public Chia CA certificate: %v", err)
+	}
+
+	var problems []string
+	for _, name := range services {
+		cas := []*x509.Certificate{publicCA}
+		if strings.HasPrefix(name, "private_") {
+			cas = privateCAs
+		}
+		if err := validateCertKeyPair(secret, name, cas); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateCertKeyPair checks the certificate and key pair for a cert node in the Secret. The certificate is checked against the CAs, unless none are given.
+func validateCertKeyPair(secret corev1.Secret, name string, cas []*x509.Certificate) error {
+	crtKey := name + ".crt"
+	keyKey := name + ".key"
+	crt, ok := secret.Data[crtKey]
+	if !ok || len(crt) == 0 {
+		return fmt.Errorf("%s is missing", crtKey)
+	}
+	key, ok := secret.Data[keyKey]
+	if !ok || len(key) == 0 {
+		return fmt.Errorf("%s is missing", keyKey)
+	}
+
+	cert, err := tls.ParsePemCertificate(crt)
+	if err != nil {
+		return fmt.Errorf("%s: %v", crtKey, err)
+	}
+	privateKey, err := tls.ParsePemKey(key)
+	if err != nil {
+		return fmt.Errorf("%s: %v", keyKey, err)
+	}
+	if !tls.CertMatchesPrivateKey(cert, privateKey) {
+		return fmt.Errorf("%s does not match %s", keyKey, crtKey)
+	}
+
+	if len(cas) == 0 {
+		return nil
+	}
+	for _, ca := range cas {
+		if cert.CheckSignatureFrom(ca) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not signed by its CA", crtKey)
+}
+
+// parsePEMCertificates parses every certificate in a PEM bundle, skipping any blocks that can't be parsed
+func parsePEMCertificates(data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
+
+// getRenewBefore returns the configured certificate renewal window, or the default if unset
+func getRenewBefore(cr k8schianetv1.ChiaCertificates) time.Duration {
+	if cr.Spec.RenewBefore != nil && cr.Spec.RenewBefore.Duration > 0 {
+		return cr.Spec.RenewBefore.Duration
+	}
+	return defaultRenewBefore
+}
+
+// getEarliestNotAfter parses every certificate in a Secret and returns the earliest expiration time among them.
+// Both the Data and StringData fields are checked, so this works on Secrets fetched from the API and on assembled Secrets.
+func getEarliestNotAfter(secret corev1.Secret) (time.Time, error) {
+	certs := make(map[string][]byte)
+	for k, v := range secret.Data {
+		certs[k] = v
+	}
+	for k, v := range secret.StringData {
+		certs[k] = []byte(v)
+	}
+
+	var earliest time.Time
+	for name, data := range certs {
+		if !strings.HasSuffix(name, ".crt") {
+			continue
+		}
+		cert, err := tls.ParsePemCertificate(data)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing certificate %s: %w", name, err)
+		}
+		if earliest.IsZero() || cert.NotAfter.Before(earliest) {
+			earliest = cert.NotAfter
+		}
+	}
+
+	if earliest.IsZero() {
+		return time.Time{}, fmt.Errorf("no certificates found in Secret %s", secret.Name)
+	}
+
+	return earliest, nil
+}
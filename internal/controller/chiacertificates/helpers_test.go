@@ -5,12 +5,18 @@ Copyright 2025 Chia Network Inc.
 package chiacertificates
 
 import (
+	"crypto/rand"
+	"crypto/x509"
 	"testing"
+	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/go-chia-libs/pkg/tls"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 var testChiaCertificates = k8schianetv1.ChiaCertificates{
@@ -98,9 +104,324 @@ func TestConstructCertMap_NilCertificate(t *testing.T) {
 		PrivateCrawler: nil, // This should cause an error
 	}
 
-	certMap, err := constructCertMap(allCerts)
+	certMap, err := constructCertMap(allCerts, getServices(testChiaCertificates))
 
 	assert.Error(t, err)
 	assert.Nil(t, certMap)
 	assert.Contains(t, err.Error(), "key pair nil")
 }
+
+func TestGetRenewBefore_Default(t *testing.T) {
+	assert.Equal(t, defaultRenewBefore, getRenewBefore(testChiaCertificates))
+}
+
+func TestGetRenewBefore_Custom(t *testing.T) {
+	customCertificates := testChiaCertificates
+	customCertificates.Spec.RenewBefore = &metav1.Duration{Duration: 48 * time.Hour}
+	assert.Equal(t, 48*time.Hour, getRenewBefore(customCertificates))
+}
+
+func TestGetEarliestNotAfter(t *testing.T) {
+	caCertDER, caKey, err := tls.GenerateNewCA()
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertDER)
+	require.NoError(t, err)
+
+	allCerts, err := tls.GenerateAllCerts(caCert, caKey)
+	require.NoError(t, err)
+	certMap, err := constructCertMap(allCerts, getServices(testChiaCertificates))
+	require.NoError(t, err)
+
+	// Swap one certificate for one that expires sooner than the rest
+	leafDER, leafKey, err := tls.GenerateCASignedCert(caCert, caKey)
+	require.NoError(t, err)
+	leafCert, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+	leafCert.NotAfter = time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	leafCert.NotBefore = time.Now().Add(-1 * time.Hour)
+	shortDER, err := x509.CreateCertificate(rand.Reader, leafCert, caCert, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+	shortCrt, _, err := tls.EncodeCertAndKeyToPEM(shortDER, leafKey)
+	require.NoError(t, err)
+
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"private_wallet.crt": shortCrt,
+		},
+		StringData: certMap,
+	}
+	delete(secret.StringData, "private_wallet.crt")
+
+	notAfter, err := getEarliestNotAfter(secret)
+	require.NoError(t, err)
+	assert.Equal(t, leafCert.NotAfter.Unix(), notAfter.Unix())
+}
+
+func TestGetEarliestNotAfter_NoCertificates(t *testing.T) {
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"private_wallet.key": []byte("key-data"),
+		},
+	}
+	_, err := getEarliestNotAfter(secret)
+	assert.Error(t, err)
+}
+
+func TestGetEarliestNotAfter_InvalidCertificate(t *testing.T) {
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"private_wallet.crt": []byte("not a certificate"),
+		},
+	}
+	_, err := getEarliestNotAfter(secret)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "private_wallet.crt")
+}
+
+func TestGetEarliestNotAfter_PublicOnly(t *testing.T) {
+	certMap, err := generateCertMap(testCASecret(t), []string{"public_full_node"})
+	require.NoError(t, err)
+	cert, err := tls.ParsePemCertificate([]byte(certMap["public_full_node.crt"]))
+	require.NoError(t, err)
+
+	notAfter, err := getEarliestNotAfter(corev1.Secret{StringData: certMap})
+	require.NoError(t, err)
+	assert.Equal(t, cert.NotAfter.Unix(), notAfter.Unix())
+}
+
+func TestSignedByCA(t *testing.T) {
+	caSecret := func() corev1.Secret {
+		caDER, caKey, err := tls.GenerateNewCA()
+		require.NoError(t, err)
+		caCrt, caKeyPEM, err := tls.EncodeCertAndKeyToPEM(caDER, caKey)
+		require.NoError(t, err)
+		return corev1.Secret{
+			Data: map[string][]byte{
+				"private_ca.crt": caCrt,
+				"private_ca.key": caKeyPEM,
+			},
+		}
+	}
+	ca := caSecret()
+	otherCA := caSecret()
+
+	certMap, err := generateCertMap(ca, getServices(testChiaCertificates))
+	require.NoError(t, err)
+	certSecret := corev1.Secret{
+		Data: map[string][]byte{},
+	}
+	for k, v := range certMap {
+		certSecret.Data[k] = []byte(v)
+	}
+
+	assert.True(t, signedByCA(certSecret, ca))
+	assert.False(t, signedByCA(certSecret, otherCA))
+}
+
+func newTestCertSecrets(t *testing.T) (corev1.Secret, corev1.Secret) {
+	caDER, caKey, err := tls.GenerateNewCA()
+	require.NoError(t, err)
+	caCrt, caKeyPEM, err := tls.EncodeCertAndKeyToPEM(caDER, caKey)
+	require.NoError(t, err)
+	caSecret := corev1.Secret{
+		Data: map[string][]byte{
+			"private_ca.crt": caCrt,
+			"private_ca.key": caKeyPEM,
+		},
+	}
+
+	certMap, err := generateCertMap(caSecret, getServices(testChiaCertificates))
+	require.NoError(t, err)
+	certSecret := corev1.Secret{
+		Data: map[string][]byte{},
+	}
+	for k, v := range certMap {
+		certSecret.Data[k] = []byte(v)
+	}
+	return certSecret, caSecret
+}
+
+func TestValidateCertSecret(t *testing.T) {
+	certSecret, caSecret := newTestCertSecrets(t)
+	require.NoError(t, validateCertSecret(certSecret, caSecret, getServices(testChiaCertificates)))
+
+	// Missing key
+	delete(certSecret.Data, "private_daemon.key")
+	err := validateCertSecret(certSecret, caSecret, getServices(testChiaCertificates))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private_daemon.key is missing")
+}
+
+func TestValidateCertSecret_Truncated(t *testing.T) {
+	certSecret, caSecret := newTestCertSecrets(t)
+	certSecret.Data["public_full_node.crt"] = certSecret.Data["public_full_node.crt"][:100]
+
+	err := validateCertSecret(certSecret, caSecret, getServices(testChiaCertificates))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public_full_node.crt")
+}
+
+func TestValidateCertSecret_MismatchedKey(t *testing.T) {
+	certSecret, caSecret := newTestCertSecrets(t)
+	certSecret.Data["private_farmer.key"] = certSecret.Data["private_harvester.key"]
+
+	err := validateCertSecret(certSecret, caSecret, getServices(testChiaCertificates))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private_farmer.key does not match private_farmer.crt")
+}
+
+func TestValidateCertSecret_OtherCA(t *testing.T) {
+	certSecret, _ := newTestCertSecrets(t)
+	_, otherCASecret := newTestCertSecrets(t)
+
+	err := validateCertSecret(certSecret, otherCASecret, getServices(testChiaCertificates))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private_full_node.crt is not signed by its CA")
+	assert.NotContains(t, err.Error(), "public_full_node.crt")
+
+}
+
+func TestValidateCertSecret_TrustBundle(t *testing.T) {
+	certSecret, caSecret := newTestCertSecrets(t)
+	_, nextCASecret := newTestCertSecrets(t)
+
+	// Certificates signed by the CA being rotated away from are valid while it is still in the trust bundle
+	bundle := nextCASecret.DeepCopy()
+	bundle.Data["private_ca.crt"] = append(bundle.Data["private_ca.crt"], caSecret.Data["private_ca.crt"]...)
+	require.NoError(t, validateCertSecret(certSecret, *bundle, getServices(testChiaCertificates)))
+}
+
+func TestGetServices_Default(t *testing.T) {
+	services := getServices(testChiaCertificates)
+	assert.Len(t, services, len(certNodes))
+	assert.Contains(t, services, "private_harvester")
+	assert.Contains(t, services, "public_full_node")
+}
+
+func TestGetServices_Custom(t *testing.T) {
+	customCertificates := testChiaCertificates
+	customCertificates.Spec.Services = []string{"private_harvester", "private_daemon"}
+	assert.Equal(t, []string{"private_harvester", "private_daemon"}, getServices(customCertificates))
+}
+
+func TestGenerateCertMap_Services(t *testing.T) {
+	_, caSecret := newTestCertSecrets(t)
+	services := []string{"private_harvester", "private_daemon"}
+
+	certMap, err := generateCertMap(caSecret, services)
+	require.NoError(t, err)
+	assert.Len(t, certMap, 4)
+	assert.Contains(t, certMap, "private_harvester.crt")
+	assert.Contains(t, certMap, "private_harvester.key")
+	assert.Contains(t, certMap, "private_daemon.crt")
+	assert.Contains(t, certMap, "private_daemon.key")
+
+	_, err = generateCertMap(caSecret, []string{"private_unknown"})
+	assert.Error(t, err)
+}
+
+func TestSecretMatchesServices(t *testing.T) {
+	certSecret, _ := newTestCertSecrets(t)
+	assert.True(t, secretMatchesServices(certSecret, getServices(testChiaCertificates)))
+
+	// The Secret has keys for services that weren't requested
+	assert.False(t, secretMatchesServices(certSecret, []string{"private_harvester", "private_daemon"}))
+
+	limited := corev1.Secret{
+		Data: map[string][]byte{
+			"private_harvester.crt": certSecret.Data["private_harvester.crt"],
+			"private_harvester.key": certSecret.Data["private_harvester.key"],
+			"private_daemon.crt":    certSecret.Data["private_daemon.crt"],
+			"private_daemon.key":    certSecret.Data["private_daemon.key"],
+		},
+	}
+	assert.True(t, secretMatchesServices(limited, []string{"private_harvester", "private_daemon"}))
+
+	// The Secret is missing a requested service
+	assert.False(t, secretMatchesServices(limited, []string{"private_harvester", "private_daemon", "private_farmer"}))
+}
+
+func TestAssembleServiceCertificate(t *testing.T) {
+	cert := assembleServiceCertificate(testChiaCertificates, "private_full_node")
+	assert.Equal(t, "testname-private-full-node", cert.GetName())
+	issuerName, _, err := unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "testname-private-ca", issuerName)
+
+	cert = assembleServiceCertificate(testChiaCertificates, "public_full_node")
+	issuerName, _, err = unstructured.NestedString(cert.Object, "spec", "issuerRef", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "testname-public-ca", issuerName)
+}
+
+func TestGetIssuerCAs(t *testing.T) {
+	_, caSecret := newTestCertSecrets(t)
+	cas, err := getIssuerCAs(caSecret)
+	require.NoError(t, err)
+	assert.Equal(t, caSecret.Data["private_ca.crt"], cas[privateCA][0])
+	chiaCrt, _ := tls.GetChiaCACertAndKey()
+	assert.Equal(t, chiaCrt, cas[publicCA][0])
+
+	_, err = getIssuerCAs(corev1.Secret{})
+	assert.Error(t, err)
+}
+
+func TestCertMapFromIssuedSecrets(t *testing.T) {
+	cr := testChiaCertificates
+	cr.Spec.Services = []string{"private_full_node", "public_full_node"}
+	services := getServices(cr)
+
+	certSecret, _ := newTestCertSecrets(t)
+	issued := map[string]corev1.Secret{}
+	_, ready := certMapFromIssuedSecrets(cr, services, issued)
+	assert.False(t, ready)
+
+	for _, service := range services {
+		issued[getServiceSecretName(cr, service)] = corev1.Secret{
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certSecret.Data[service+".crt"],
+				corev1.TLSPrivateKeyKey: certSecret.Data[service+".key"],
+			},
+		}
+	}
+	certMap, ready := certMapFromIssuedSecrets(cr, services, issued)
+	require.True(t, ready)
+	assert.Len(t, certMap, 2*len(services))
+	assert.True(t, secretMatchesCertMap(corev1.Secret{Data: map[string][]byte{
+		"private_full_node.crt": certSecret.Data["private_full_node.crt"],
+		"private_full_node.key": certSecret.Data["private_full_node.key"],
+		"public_full_node.crt":  certSecret.Data["public_full_node.crt"],
+		"public_full_node.key":  certSecret.Data["public_full_node.key"],
+	}}, certMap))
+	assert.False(t, secretMatchesCertMap(certSecret, certMap))
+}
+
+func TestIssuedByCA(t *testing.T) {
+	certSecret, caSecret := newTestCertSecrets(t)
+	_, otherCASecret := newTestCertSecrets(t)
+	issued := corev1.Secret{
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certSecret.Data["private_full_node.crt"],
+			corev1.TLSPrivateKeyKey: certSecret.Data["private_full_node.key"],
+		},
+	}
+
+	assert.True(t, issuedByCA(issued, caSecret.Data["private_ca.crt"]))
+	assert.False(t, issuedByCA(issued, otherCASecret.Data["private_ca.crt"]), "certificate issued by a previous CA should be re-issued")
+	assert.False(t, issuedByCA(corev1.Secret{}, caSecret.Data["private_ca.crt"]))
+}
+
+func TestGetServiceCAType(t *testing.T) {
+	assert.Equal(t, privateCA, getServiceCAType("private_harvester"))
+	assert.Equal(t, publicCA, getServiceCAType("public_full_node"))
+}
+
+func TestSecretRefs(t *testing.T) {
+	cr := testChiaCertificates
+	cr.Spec.CASecretName = "ca"
+	assert.Equal(t, []string{"testname", "ca"}, secretRefs(cr))
+
+	cr.Spec.Backend = k8schianetv1.CertificateBackendCertManager
+	cr.Spec.Services = []string{"private_harvester"}
+	assert.Equal(t, []string{"testname", "ca", "testname-private-ca", "testname-public-ca", "testname-private-harvester"}, secretRefs(cr))
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/chia-network/go-chia-libs/pkg/tls"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+)
+
+// issueTestCertificate returns the PEM encoded certificate and key for a new certificate signed by the PEM encoded CA
+func issueTestCertificate(caCrt, caKey []byte) ([]byte, []byte) {
+	caCert, err := tls.ParsePemCertificate(caCrt)
+	Expect(err).NotTo(HaveOccurred())
+	caPrivateKey, err := tls.ParsePemKey(caKey)
+	Expect(err).NotTo(HaveOccurred())
+	certDER, certKey, err := tls.GenerateCASignedCert(caCert, caPrivateKey)
+	Expect(err).NotTo(HaveOccurred())
+	crt, key, err := tls.EncodeCertAndKeyToPEM(certDER, certKey)
+	Expect(err).NotTo(HaveOccurred())
+	return crt, key
+}
+
+var _ = Describe("ChiaCertificates controller", func() {
+	var (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("When creating ChiaCertificates with the CertManager backend", func() {
+		It("should have cert-manager re-issue the certificates when the private CA changes", func() {
+			ctx := context.Background()
+
+			By("By creating a CA Secret")
+			oldCACrt, oldCAKey := generateTestCA()
+			caSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-certmanager-certs-ca",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"private_ca.crt": oldCACrt,
+					"private_ca.key": oldCAKey,
+				},
+			}
+			Expect(k8sClient.Create(ctx, caSecret)).Should(Succeed())
+
+			By("By creating a new ChiaCertificates")
+			testCerts := &apiv1.ChiaCertificates{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "k8s.chia.net/v1",
+					Kind:       "ChiaCertificates",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-certmanager-certs",
+					Namespace: "default",
+				},
+				Spec: apiv1.ChiaCertificatesSpec{
+					CASecretName: caSecret.Name,
+					Backend:      apiv1.CertificateBackendCertManager,
+					Services:     []string{"private_harvester"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, testCerts)).Should(Succeed())
+
+			// The operator creates a Certificate for cert-manager to issue the service's certificate from
+			cert := &unstructured.Unstructured{}
+			cert.SetGroupVersionKind(kube.CertManagerCertificateGVK)
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-certmanager-certs-private-harvester", Namespace: "default"}, cert)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+
+			By("By issuing the certificate like cert-manager would")
+			oldCrt, oldKey := issueTestCertificate(oldCACrt, oldCAKey)
+			issued := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-certmanager-certs-private-harvester",
+					Namespace: "default",
+				},
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       oldCrt,
+					corev1.TLSPrivateKeyKey: oldKey,
+				},
+			}
+			Expect(k8sClient.Create(ctx, issued)).Should(Succeed())
+
+			// The issued certificate is copied into the certificates Secret
+			certSecret := &corev1.Secret{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-certmanager-certs", Namespace: "default"}, certSecret)
+				return err == nil && bytes.Equal(certSecret.Data["private_harvester.crt"], oldCrt)
+			}, timeout, interval).Should(BeTrue())
+
+			By("By changing the private CA")
+			newCACrt, newCAKey := generateTestCA()
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: caSecret.Name, Namespace: caSecret.Namespace}, caSecret)).Should(Succeed())
+			caSecret.Data["private_ca.crt"] = newCACrt
+			caSecret.Data["private_ca.key"] = newCAKey
+			Expect(k8sClient.Update(ctx, caSecret)).Should(Succeed())
+
+			// The certificate issued by the old CA is deleted, so cert-manager re-issues it
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: issued.Name, Namespace: issued.Namespace}, &corev1.Secret{})
+				return errors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+
+			By("By re-issuing the certificate like cert-manager would")
+			newCrt, newKey := issueTestCertificate(newCACrt, newCAKey)
+			issued = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-certmanager-certs-private-harvester",
+					Namespace: "default",
+				},
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       newCrt,
+					corev1.TLSPrivateKeyKey: newKey,
+				},
+			}
+			Expect(k8sClient.Create(ctx, issued)).Should(Succeed())
+
+			// The re-issued certificate replaces the old one in the certificates Secret
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-certmanager-certs", Namespace: "default"}, certSecret)
+				return err == nil && bytes.Equal(certSecret.Data["private_harvester.crt"], newCrt)
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+})
@@ -10,7 +10,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -249,6 +251,44 @@ func assembleVolumeClaim(crawler k8schianetv1.ChiaCrawler) (*corev1.PersistentVo
 	return &pvc, nil
 }
 
+// assemblePodDisruptionBudget assembles the PodDisruptionBudget resource for a ChiaCrawler CR
+func assemblePodDisruptionBudget(crawler k8schianetv1.ChiaCrawler) policyv1.PodDisruptionBudget {
+	inputs := kube.AssemblePodDisruptionBudgetInputs{
+		Name:           fmt.Sprintf(chiacrawlerNamePattern, crawler.Name),
+		Namespace:      crawler.Namespace,
+		Labels:         kube.GetCommonLabels(crawler.Kind, crawler.ObjectMeta, crawler.Spec.Labels),
+		Annotations:    crawler.Spec.Annotations,
+		SelectorLabels: kube.GetCommonLabels(crawler.Kind, crawler.ObjectMeta),
+	}
+
+	if crawler.Spec.PodDisruptionBudget != nil {
+		inputs.MinAvailable = crawler.Spec.PodDisruptionBudget.MinAvailable
+		inputs.MaxUnavailable = crawler.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+
+	return kube.AssemblePodDisruptionBudget(inputs)
+}
+
+// assembleHorizontalPodAutoscaler assembles the HorizontalPodAutoscaler resource for a ChiaCrawler CR
+func assembleHorizontalPodAutoscaler(crawler k8schianetv1.ChiaCrawler) autoscalingv2.HorizontalPodAutoscaler {
+	inputs := kube.AssembleHorizontalPodAutoscalerInputs{
+		Name:           fmt.Sprintf(chiacrawlerNamePattern, crawler.Name),
+		Namespace:      crawler.Namespace,
+		Labels:         kube.GetCommonLabels(crawler.Kind, crawler.ObjectMeta, crawler.Spec.Labels),
+		Annotations:    crawler.Spec.Annotations,
+		DeploymentName: fmt.Sprintf(chiacrawlerNamePattern, crawler.Name),
+	}
+
+	if crawler.Spec.Autoscaling != nil {
+		inputs.MinReplicas = crawler.Spec.Autoscaling.MinReplicas
+		inputs.MaxReplicas = crawler.Spec.Autoscaling.MaxReplicas
+		inputs.Metrics = crawler.Spec.Autoscaling.Metrics
+		inputs.Behavior = crawler.Spec.Autoscaling.Behavior
+	}
+
+	return kube.AssembleHorizontalPodAutoscaler(inputs)
+}
+
 // assembleDeployment assembles the crawler Deployment resource for a ChiaCrawler CR
 func assembleDeployment(crawler k8schianetv1.ChiaCrawler, fullNodePort int32, networkData *map[string]string) (appsv1.Deployment, error) {
 	var deploy = appsv1.Deployment{
@@ -288,7 +328,10 @@ func assembleDeployment(crawler k8schianetv1.ChiaCrawler, fullNodePort int32, ne
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(crawler.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(crawler.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(crawler.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range crawler.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -309,6 +352,11 @@ func assembleDeployment(crawler k8schianetv1.ChiaCrawler, fullNodePort int32, ne
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, assembleChiaExporterContainer(crawler))
 	}
 
+	// A HorizontalPodAutoscaler sets the replicas while autoscaling is enabled, so they're left to it
+	if !kube.ShouldMakeHorizontalPodAutoscaler(crawler.Spec.Autoscaling) {
+		deploy.Spec.Replicas = &crawler.Spec.Replicas
+	}
+
 	if crawler.Spec.Strategy != nil {
 		deploy.Spec.Strategy = *crawler.Spec.Strategy
 	}
@@ -317,7 +365,10 @@ func assembleDeployment(crawler k8schianetv1.ChiaCrawler, fullNodePort int32, ne
 		deploy.Spec.Template.Spec.SecurityContext = crawler.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, crawler.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
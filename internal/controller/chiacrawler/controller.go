@@ -11,16 +11,23 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +39,37 @@ type ChiaCrawlerReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiacrawlers = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaCrawler resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the crawler RPC is queried for the ChiaCrawler status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	// PeerMetrics exports the peer counts in the ChiaCrawler status as operator metrics
+	PeerMetrics bool
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacrawlers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacrawlers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiacrawlers/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaCrawlerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaCrawlerKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +77,7 @@ func (r *ChiaCrawlerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	var crawler k8schianetv1.ChiaCrawler
 	err := r.Get(ctx, req.NamespacedName, &crawler)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiacrawlers[req.String()]
-		if exists {
-			delete(chiacrawlers, req.String())
-			metrics.ChiaCrawlers.Sub(1.0)
-		}
+		metrics.DeleteCrawlerPeers(string(consts.ChiaCrawlerKind), req.Namespace, req.Name)
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,83 +85,76 @@ func (r *ChiaCrawlerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiacrawlers[req.String()]
-	if !exists {
-		chiacrawlers[req.String()] = true
-		metrics.ChiaCrawlers.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, crawler.Spec.ChiaConfig.CommonSpecChia, crawler.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &crawler, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Get the full_node Port and handle the error one time instead of in every function that needs it
 	fullNodePort, err := kube.GetFullNodePort(crawler.Spec.ChiaConfig.CommonSpecChia, networkData)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err)
+		return r.markFailed(ctx, &crawler, kube.ReasonChiaNetworkFailed, ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err))
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(crawler, fullNodePort)
 	if err := controllerutil.SetControllerReference(&crawler, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, crawler.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(crawler, fullNodePort)
 	if err := controllerutil.SetControllerReference(&crawler, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, crawler.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(crawler)
 	if err := controllerutil.SetControllerReference(&crawler, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, crawler.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(crawler)
 	if err := controllerutil.SetControllerReference(&crawler, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, crawler.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(crawler)
 	if err := controllerutil.SetControllerReference(&crawler, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, crawler.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -151,40 +162,106 @@ func (r *ChiaCrawlerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		pvc, err := assembleVolumeClaim(crawler)
 		if err != nil {
 			r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &crawler, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, crawler.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to create crawler PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &crawler, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &crawler, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
+	// Assemble PodDisruptionBudget
+	pdb := assemblePodDisruptionBudget(crawler)
+	if err := controllerutil.SetControllerReference(&crawler, &pdb, r.Scheme); err != nil {
+		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &crawler, kube.ReasonPodDisruptionBudgetFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling PodDisruptionBudget: %v", req.NamespacedName, err))
+	}
+	// Reconcile PodDisruptionBudget
+	res, err = kube.ReconcilePodDisruptionBudget(ctx, r.Client, crawler.Spec.PodDisruptionBudget, pdb)
+	if err != nil {
+		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to create crawler PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &crawler, kube.ReasonPodDisruptionBudgetFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+	}
+
+	// Assemble HorizontalPodAutoscaler
+	hpa := assembleHorizontalPodAutoscaler(crawler)
+	if err := controllerutil.SetControllerReference(&crawler, &hpa, r.Scheme); err != nil {
+		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &crawler, kube.ReasonHorizontalPodAutoscalerFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s encountered error assembling HorizontalPodAutoscaler: %v", req.NamespacedName, err))
+	}
+	// Reconcile HorizontalPodAutoscaler
+	res, err = kube.ReconcileHorizontalPodAutoscaler(ctx, r.Client, crawler.Spec.Autoscaling, hpa)
+	if err != nil {
+		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to create crawler HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &crawler, kube.ReasonHorizontalPodAutoscalerFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+	}
+
 	// Assemble Deployment
 	deploy, err := assembleDeployment(crawler, fullNodePort, networkData)
 	if err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&crawler, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to assemble crawler Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, crawler.Namespace, &deploy.Spec.Template, secretRefs(crawler), kube.ConfigMapRefs(crawler.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &crawler, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+	}
+	// Replicas that land on different nodes can't share a claim that only one node can mount at a time
+	if kube.GetMaxReplicas(crawler.Spec.Replicas, crawler.Spec.Autoscaling) > 1 {
+		if err := kube.CheckVolumeClaimsShareable(ctx, r.Client, crawler.Namespace, deploy.Spec.Template.Spec.Volumes); err != nil {
+			r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to scale crawler Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &crawler, kube.ReasonPersistentVolumeClaimFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+		}
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &crawler.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&crawler, corev1.EventTypeWarning, "Failed", "Failed to create crawler Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &crawler, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
+	}
+	if crawler.Status.Recreation != nil {
+		return r.markRecreating(ctx, &crawler, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &crawler, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&crawler, corev1.EventTypeNormal, "Created", "Successfully created ChiaCrawler resources.")
-	crawler.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !crawler.Status.Ready {
+		r.Recorder.Event(&crawler, corev1.EventTypeNormal, "Created", "Successfully created ChiaCrawler resources.")
+	}
+	crawler.Status.Ready = rollout.Ready()
+	crawler.Status.ObservedGeneration = crawler.Generation
+	crawler.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&crawler.Status.Conditions, crawler.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		status, err := r.getPeerStatus(ctx, crawler, deploy.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaCrawlerReconciler ChiaCrawler=%s unable to query crawler RPC", req.NamespacedName))
+			metrics.DeleteCrawlerPeers(string(consts.ChiaCrawlerKind), crawler.Namespace, crawler.Name)
+		} else {
+			crawler.Status.Peers = status
+			if r.PeerMetrics && status != nil && status.Error == "" {
+				metrics.SetCrawlerPeers(string(consts.ChiaCrawlerKind), crawler.Namespace, crawler.Name, *status)
+			} else {
+				metrics.DeleteCrawlerPeers(string(consts.ChiaCrawlerKind), crawler.Namespace, crawler.Name)
+			}
+		}
+	}
 	err = r.Status().Update(ctx, &crawler)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -194,43 +271,133 @@ func (r *ChiaCrawlerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaCrawler's status conditions, and returns the reconcile error
+func (r *ChiaCrawlerReconciler) markFailed(ctx context.Context, crawler *k8schianetv1.ChiaCrawler, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaCrawlerKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&crawler.Status.Conditions, crawler.Generation, reason, err.Error()) {
+		crawler.Status.ObservedGeneration = crawler.Generation
+		if updateErr := r.Status().Update(ctx, crawler); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaCrawler status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaCrawler's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaCrawlerReconciler) markRecreating(ctx context.Context, crawler *k8schianetv1.ChiaCrawler, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&crawler.Status.Conditions, crawler.Generation, "Deployment", *crawler.Status.Recreation)
+	crawler.Status.ObservedGeneration = crawler.Generation
+	if err := r.Status().Update(ctx, crawler); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaCrawlerReconciler ChiaCrawler=%s unable to update ChiaCrawler status: %v", client.ObjectKeyFromObject(crawler), err)
+	}
+	return res, nil
+}
+
+// getPeerStatus queries the crawler RPC of the ChiaCrawler's running pod for the peers it has found on the network.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaCrawler's CA Secret.
+// Returns nil if the ChiaCrawler has no CA Secret, since its certificates are then signed by a CA the operator doesn't have, or if it has no running pod.
+func (r *ChiaCrawlerReconciler) getPeerStatus(ctx context.Context, crawler k8schianetv1.ChiaCrawler, selector *metav1.LabelSelector) (*k8schianetv1.CrawlerPeerStatus, error) {
+	if crawler.Spec.ChiaConfig.CASecretName == nil || *crawler.Spec.ChiaConfig.CASecretName == "" {
+		return nil, nil
+	}
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, crawler.Namespace, *crawler.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, crawler.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	host := pods[0].Status.PodIP
+
+	counts, err := rpcClient.GetPeerCounts(ctx, host, consts.CrawlerRPCPort)
+	if err != nil {
+		return &k8schianetv1.CrawlerPeerStatus{Error: err.Error()}, nil
+	}
+	seenLastHour, err := rpcClient.CountIPsAfterTimestamp(ctx, host, consts.CrawlerRPCPort, time.Now().Add(-time.Hour))
+	if err != nil {
+		return &k8schianetv1.CrawlerPeerStatus{Error: err.Error()}, nil
+	}
+
+	return peerStatus(counts, seenLastHour), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaCrawlerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaCrawlers by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaCrawler{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaCrawler))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaCrawler{}).
+		For(&k8schianetv1.ChiaCrawler{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaCrawlers that use the ChiaNetwork
 func (r *ChiaCrawlerReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaCrawlerList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaCrawlers that reference it
+func (r *ChiaCrawlerReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaCrawlerList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
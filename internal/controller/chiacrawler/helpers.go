@@ -6,11 +6,14 @@ package chiacrawler
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	corev1 "k8s.io/api/core/v1"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"k8s.io/utils/ptr"
 )
 
 // getChiaVolumes retrieves the requisite volumes from the Chia config struct
@@ -43,6 +46,11 @@ func getChiaVolumes(crawler k8schianetv1.ChiaCrawler) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(crawler.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(crawler.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(crawler.Spec.ChiaConfig.CommonSpecChia, crawler.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
@@ -64,6 +72,11 @@ func getChiaVolumeMounts(crawler k8schianetv1.ChiaCrawler) []corev1.VolumeMount
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(crawler.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	return v
 }
 
@@ -92,3 +105,29 @@ func getChiaEnv(crawler k8schianetv1.ChiaCrawler, networkData *map[string]string
 
 	return env, nil
 }
+
+// peerStatus converts a crawler's RPC responses to a CrawlerPeerStatus
+func peerStatus(counts chiarpc.PeerCounts, seenLastHour int) *k8schianetv1.CrawlerPeerStatus {
+	status := k8schianetv1.CrawlerPeerStatus{
+		Total:        int32(counts.TotalLast5Days),
+		Reliable:     int32(counts.ReliableNodes),
+		IPv4:         int32(counts.IPv4Last5Days),
+		IPv6:         int32(counts.IPv6Last5Days),
+		SeenLastHour: int32(seenLastHour),
+	}
+	for version, count := range counts.Versions {
+		status.Versions = append(status.Versions, k8schianetv1.CrawlerPeerVersion{
+			Version: version,
+			Count:   int32(count),
+		})
+	}
+	sort.Slice(status.Versions, func(i, j int) bool {
+		return status.Versions[i].Version < status.Versions[j].Version
+	})
+	return &status
+}
+
+// secretRefs returns the names of the Secrets the ChiaCrawler's pods reference, including its CA Secret
+func secretRefs(crawler k8schianetv1.ChiaCrawler) []string {
+	return kube.SecretRefs(crawler.Spec.ChiaConfig.CommonSpecChia, crawler.Spec.ChiaExporterConfig, ptr.Deref(crawler.Spec.ChiaConfig.CASecretName, ""))
+}
@@ -3,6 +3,7 @@ package chiacrawler
 import (
 	"testing"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -230,3 +231,28 @@ func TestGetChiaVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestPeerStatus(t *testing.T) {
+	counts := chiarpc.PeerCounts{
+		TotalLast5Days: 30000,
+		ReliableNodes:  8000,
+		IPv4Last5Days:  25000,
+		IPv6Last5Days:  5000,
+		Versions:       map[string]int{"2.5.0": 20000, "2.4.4": 10000},
+	}
+
+	assert.Equal(t, &k8schianetv1.CrawlerPeerStatus{
+		Total:        30000,
+		Reliable:     8000,
+		IPv4:         25000,
+		IPv6:         5000,
+		SeenLastHour: 12000,
+		Versions: []k8schianetv1.CrawlerPeerVersion{
+			{Version: "2.4.4", Count: 10000},
+			{Version: "2.5.0", Count: 20000},
+		},
+	}, peerStatus(counts, 12000))
+
+	// A crawler that just started hasn't found any peers
+	assert.Equal(t, &k8schianetv1.CrawlerPeerStatus{}, peerStatus(chiarpc.PeerCounts{}, 0))
+}
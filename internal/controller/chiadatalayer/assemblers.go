@@ -243,7 +243,10 @@ func assembleDeployment(ctx context.Context, datalayer k8schianetv1.ChiaDataLaye
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(datalayer.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(datalayer.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(datalayer.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range datalayer.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -263,7 +266,8 @@ func assembleDeployment(ctx context.Context, datalayer k8schianetv1.ChiaDataLaye
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, assembleChiaExporterContainer(datalayer))
 	}
 
-	if datalayer.Spec.FileserverConfig.Enabled != nil && *datalayer.Spec.FileserverConfig.Enabled {
+	// A fileserver that runs in a Deployment of its own isn't added as a sidecar
+	if datalayer.Spec.FileserverConfig.Enabled != nil && *datalayer.Spec.FileserverConfig.Enabled && !fileserver.ShouldRunSeparately(datalayer) {
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, fileserver.AssembleContainer(datalayer))
 	}
 
@@ -275,7 +279,10 @@ func assembleDeployment(ctx context.Context, datalayer k8schianetv1.ChiaDataLaye
 		deploy.Spec.Template.Spec.SecurityContext = datalayer.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, datalayer.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -13,17 +13,22 @@ import (
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/chiadatalayer/fileserver"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,22 +40,30 @@ type ChiaDataLayerReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiadatalayers = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaDataLayer resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiadatalayers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiadatalayers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiadatalayers/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaDataLayerKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -58,12 +71,6 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	var datalayer k8schianetv1.ChiaDataLayer
 	err := r.Get(ctx, req.NamespacedName, &datalayer)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiadatalayers[req.String()]
-		if exists {
-			delete(chiadatalayers, req.String())
-			metrics.ChiaDataLayers.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -71,82 +78,75 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiadatalayers[req.String()]
-	if !exists {
-		chiadatalayers[req.String()] = true
-		metrics.ChiaDataLayers.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, datalayer.Spec.ChiaConfig.CommonSpecChia, datalayer.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(datalayer)
 	if err := controllerutil.SetControllerReference(&datalayer, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling daemon Service: %v", err)
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling daemon Service: %v", err))
 	}
 	// Reconcile Daemon Service
 	res, err := kube.ReconcileService(ctx, r.Client, datalayer.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer daemon Service -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, res, err)
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(datalayer)
 	if err := controllerutil.SetControllerReference(&datalayer, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling RPC Service: %v", err)
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling RPC Service: %v", err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, datalayer.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer RPC Service -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, res, err)
 	}
 
 	// Assemble HTTP Service
 	httpSrv := fileserver.AssembleService(datalayer)
 	if err := controllerutil.SetControllerReference(&datalayer, &httpSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer HTTP Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling HTTP Service: %v", err)
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling HTTP Service: %v", err))
 	}
 	// Reconcile HTTP Service
 	res, err = kube.ReconcileService(ctx, r.Client, datalayer.Spec.FileserverConfig.Service, httpSrv, true)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer HTTP Service -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, res, err)
 	}
 
 	// Assemble fileserver Ingress
 	ingress := fileserver.AssembleIngress(datalayer)
 	if err := controllerutil.SetControllerReference(&datalayer, &ingress, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer Ingress -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling Ingress: %v", err)
+		return r.markFailed(ctx, &datalayer, kube.ReasonIngressFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling Ingress: %v", err))
 	}
 	// Reconcile fileserver Ingress
 	res, err = kube.ReconcileIngress(ctx, r.Client, datalayer.Spec.FileserverConfig.Ingress, ingress)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer Ingress -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonIngressFailed, res, err)
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(datalayer)
 	if err := controllerutil.SetControllerReference(&datalayer, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling chia-exporter Service: %v", err)
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling chia-exporter Service: %v", err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, datalayer.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer chia-exporter Service -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonServiceFailed, res, err)
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -154,14 +154,14 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		pvc, err := assembleChiaRootVolumeClaim(datalayer)
 		if err != nil {
 			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer PVC -- Check operator logs.")
-			return reconcile.Result{}, err
+			return r.markFailed(ctx, &datalayer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, err)
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, datalayer.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to create datalayer CHIA_ROOT PVC -- Check operator logs.")
-				return res, err
+				return r.markFailed(ctx, &datalayer, kube.ReasonPersistentVolumeClaimFailed, res, err)
 			}
 		} else {
 			return reconcile.Result{}, stdErrors.New("CHIA_ROOT PVC could not be created")
@@ -173,14 +173,14 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		pvc, err := assembleDataLayerFilesVolumeClaim(datalayer)
 		if err != nil {
 			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer server files PVC -- Check operator logs.")
-			return reconcile.Result{}, err
+			return r.markFailed(ctx, &datalayer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, err)
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, datalayer.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to create datalayer server files PVC -- Check operator logs.")
-				return res, err
+				return r.markFailed(ctx, &datalayer, kube.ReasonPersistentVolumeClaimFailed, res, err)
 			}
 		} else {
 			return reconcile.Result{}, stdErrors.New("server files PVC could not be created")
@@ -191,22 +191,117 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	deploy, err := assembleDeployment(ctx, datalayer, networkData)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer Deployment -- Check operator logs.")
-		return reconcile.Result{}, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, reconcile.Result{}, err)
 	}
 	if err := controllerutil.SetControllerReference(&datalayer, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer Deployment -- Check operator logs.")
-		return reconcile.Result{}, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, reconcile.Result{}, err)
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, datalayer.Namespace, &deploy.Spec.Template, secretRefs(datalayer), kube.ConfigMapRefs(datalayer.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, err)
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &datalayer.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to create datalayer Deployment -- Check operator logs.")
-		return res, err
+		return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, res, err)
+	}
+	if datalayer.Status.Recreation != nil {
+		return r.markRecreating(ctx, &datalayer, "Deployment", *datalayer.Status.Recreation, res)
+	}
+
+	// Assemble fileserver Deployment
+	fileserverDeploy := fileserver.AssembleDeployment(datalayer, getServerFilesVolume(datalayer))
+	if fileserver.ShouldRunSeparately(datalayer) {
+		// The fileserver's pods share the server files with the datalayer's pod, and can be scheduled to other nodes
+		if fileserverDeploy.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim == nil {
+			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer fileserver Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, stdErrors.New("the fileserver can only run in its own Deployment when storage.dataLayerServerFiles is a persistentVolumeClaim"))
+		}
+		if err := kube.CheckVolumeClaimsShareable(ctx, r.Client, datalayer.Namespace, fileserverDeploy.Spec.Template.Spec.Volumes); err != nil {
+			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to scale datalayer fileserver Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &datalayer, kube.ReasonPersistentVolumeClaimFailed, ctrl.Result{}, err)
+		}
+		if err := controllerutil.SetControllerReference(&datalayer, &fileserverDeploy, r.Scheme); err != nil {
+			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer fileserver Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, err)
+		}
+		// Reconcile fileserver Deployment
+		if datalayer.Status.Fileserver == nil {
+			datalayer.Status.Fileserver = &k8schianetv1.WorkloadStatus{}
+		}
+		res, err = kube.ReconcileDeployment(ctx, r.Client, fileserverDeploy, datalayer.Status.Fileserver)
+		if err != nil {
+			r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to create datalayer fileserver Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, res, err)
+		}
+		if datalayer.Status.Fileserver.Recreation != nil {
+			return r.markRecreating(ctx, &datalayer, "fileserver Deployment", *datalayer.Status.Fileserver.Recreation, res)
+		}
+	} else {
+		// Remove the fileserver's own Deployment if it went back to running as a sidecar
+		datalayer.Status.Fileserver = nil
+		if err := kube.DeleteObject(ctx, r.Client, "Deployment", &fileserverDeploy); err != nil {
+			return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, err)
+		}
+	}
+
+	// The fileserver's HorizontalPodAutoscaler and PodDisruptionBudget only exist alongside its own Deployment
+	var fileserverAutoscaling *k8schianetv1.AutoscalingConfig
+	var fileserverPDB *k8schianetv1.PodDisruptionBudgetConfig
+	if fileserver.ShouldRunSeparately(datalayer) {
+		fileserverAutoscaling = datalayer.Spec.FileserverConfig.Autoscaling
+		fileserverPDB = datalayer.Spec.FileserverConfig.PodDisruptionBudget
+	}
+
+	// Assemble fileserver HorizontalPodAutoscaler
+	hpa := fileserver.AssembleHorizontalPodAutoscaler(datalayer)
+	if err := controllerutil.SetControllerReference(&datalayer, &hpa, r.Scheme); err != nil {
+		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer fileserver HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &datalayer, kube.ReasonHorizontalPodAutoscalerFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling HorizontalPodAutoscaler: %v", err))
+	}
+	// Reconcile fileserver HorizontalPodAutoscaler
+	res, err = kube.ReconcileHorizontalPodAutoscaler(ctx, r.Client, fileserverAutoscaling, hpa)
+	if err != nil {
+		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer fileserver HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &datalayer, kube.ReasonHorizontalPodAutoscalerFailed, res, err)
+	}
+
+	// Assemble fileserver PodDisruptionBudget
+	pdb := fileserver.AssemblePodDisruptionBudget(datalayer)
+	if err := controllerutil.SetControllerReference(&datalayer, &pdb, r.Scheme); err != nil {
+		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to assemble datalayer fileserver PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &datalayer, kube.ReasonPodDisruptionBudgetFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling PodDisruptionBudget: %v", err))
+	}
+	// Reconcile fileserver PodDisruptionBudget
+	res, err = kube.ReconcilePodDisruptionBudget(ctx, r.Client, fileserverPDB, pdb)
+	if err != nil {
+		r.Recorder.Event(&datalayer, corev1.EventTypeWarning, "Failed", "Failed to reconcile datalayer fileserver PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &datalayer, kube.ReasonPodDisruptionBudgetFailed, res, err)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, err)
+	}
+
+	// Get the fileserver Deployment's rollout, which is reported separately in the CR status
+	if datalayer.Status.Fileserver != nil {
+		fileserverRollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: fileserverDeploy.Namespace, Name: fileserverDeploy.Name})
+		if err != nil {
+			return r.markFailed(ctx, &datalayer, kube.ReasonDeploymentFailed, ctrl.Result{}, err)
+		}
+		datalayer.Status.Fileserver = &fileserverRollout.Status
 	}
 
 	// Update CR status
 	r.Recorder.Event(&datalayer, corev1.EventTypeNormal, "Created", "Successfully created ChiaDataLayer resources.")
-	datalayer.Status.Ready = true
+	datalayer.Status.Ready = rollout.Ready()
+	datalayer.Status.ObservedGeneration = datalayer.Generation
+	datalayer.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&datalayer.Status.Conditions, datalayer.Generation, rollout)
 	err = r.Status().Update(ctx, &datalayer)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -219,41 +314,97 @@ func (r *ChiaDataLayerReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return ctrl.Result{}, nil
 }
 
+// markFailed records a subresource that couldn't be reconciled in the ChiaDataLayer's status conditions, and returns the reconcile error
+func (r *ChiaDataLayerReconciler) markFailed(ctx context.Context, datalayer *k8schianetv1.ChiaDataLayer, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaDataLayerKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&datalayer.Status.Conditions, datalayer.Generation, reason, err.Error()) {
+		datalayer.Status.ObservedGeneration = datalayer.Generation
+		if updateErr := r.Status().Update(ctx, datalayer); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaDataLayer status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of one of the ChiaDataLayer's Deployments in its status, and requeues the reconcile to carry on with it
+func (r *ChiaDataLayerReconciler) markRecreating(ctx context.Context, datalayer *k8schianetv1.ChiaDataLayer, kind string, recreation k8schianetv1.WorkloadRecreation, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&datalayer.Status.Conditions, datalayer.Generation, kind, recreation)
+	datalayer.Status.ObservedGeneration = datalayer.Generation
+	if err := r.Status().Update(ctx, datalayer); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaDataLayerReconciler ChiaDataLayer=%s unable to update ChiaDataLayer status: %v", client.ObjectKeyFromObject(datalayer), err)
+	}
+	return res, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaDataLayerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaDataLayers by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaDataLayer{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaDataLayer))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8schianetv1.ChiaDataLayer{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Owns(&networkingv1.Ingress{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaDataLayers that use the ChiaNetwork
 func (r *ChiaDataLayerReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaDataLayerList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
 	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaDataLayers that reference it
+func (r *ChiaDataLayerReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaDataLayerList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
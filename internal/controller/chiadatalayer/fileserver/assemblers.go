@@ -6,8 +6,11 @@ import (
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -15,6 +18,21 @@ import (
 
 const chiadatalayerfileserverNamePattern = "%s-datalayer-http"
 
+// ShouldRunSeparately returns true if the fileserver runs in a Deployment of its own instead of as a sidecar to the chia container.
+// This is the case when the fileserver is enabled and given a number of replicas, or autoscaling.
+func ShouldRunSeparately(datalayer k8schianetv1.ChiaDataLayer) bool {
+	if datalayer.Spec.FileserverConfig.Enabled == nil || !*datalayer.Spec.FileserverConfig.Enabled {
+		return false
+	}
+	return datalayer.Spec.FileserverConfig.Replicas != nil || kube.ShouldMakeHorizontalPodAutoscaler(datalayer.Spec.FileserverConfig.Autoscaling)
+}
+
+// getLabels gives the labels of the fileserver's own Deployment and its pods.
+// They're labeled as an instance of their own, so the datalayer's Deployment and Services don't select the fileserver's pods.
+func getLabels(datalayer k8schianetv1.ChiaDataLayer, additionalLabels ...map[string]string) map[string]string {
+	return kube.GetCommonLabels(datalayer.Kind, metav1.ObjectMeta{Name: fmt.Sprintf(chiadatalayerfileserverNamePattern, datalayer.Name)}, additionalLabels...)
+}
+
 // AssembleService assembles the fileserver Service resource for a ChiaDataLayer CR
 func AssembleService(datalayer k8schianetv1.ChiaDataLayer) corev1.Service {
 	inputs := kube.AssembleCommonServiceInputs{
@@ -44,6 +62,9 @@ func AssembleService(datalayer k8schianetv1.ChiaDataLayer) corev1.Service {
 	}
 	inputs.Labels = kube.GetCommonLabels(datalayer.Kind, datalayer.ObjectMeta, datalayer.Spec.Labels, additionalServiceLabels)
 	inputs.SelectorLabels = kube.GetCommonLabels(datalayer.Kind, datalayer.ObjectMeta, datalayer.Spec.Labels)
+	if ShouldRunSeparately(datalayer) {
+		inputs.SelectorLabels = getLabels(datalayer, datalayer.Spec.Labels)
+	}
 
 	// Annotations
 	var additionalServiceAnnotations = make(map[string]string)
@@ -149,6 +170,92 @@ func AssembleContainer(datalayer k8schianetv1.ChiaDataLayer) corev1.Container {
 	return container
 }
 
+// AssembleDeployment assembles the fileserver's own Deployment resource for a ChiaDataLayer CR.
+// serverFilesVolume is the data_layer server files volume, which the fileserver serves from.
+func AssembleDeployment(datalayer k8schianetv1.ChiaDataLayer, serverFilesVolume corev1.Volume) appsv1.Deployment {
+	var deploy = appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf(chiadatalayerfileserverNamePattern, datalayer.Name),
+			Namespace:   datalayer.Namespace,
+			Labels:      getLabels(datalayer, datalayer.Spec.Labels),
+			Annotations: datalayer.Spec.Annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: getLabels(datalayer),
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      getLabels(datalayer, datalayer.Spec.Labels),
+					Annotations: datalayer.Spec.Annotations,
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: datalayer.Spec.NodeSelector,
+					Containers:   []corev1.Container{AssembleContainer(datalayer)},
+					Volumes:      []corev1.Volume{serverFilesVolume},
+				},
+			},
+		},
+	}
+
+	// A HorizontalPodAutoscaler sets the replicas while autoscaling is enabled, so they're left to it
+	if !kube.ShouldMakeHorizontalPodAutoscaler(datalayer.Spec.FileserverConfig.Autoscaling) {
+		deploy.Spec.Replicas = datalayer.Spec.FileserverConfig.Replicas
+	}
+
+	if datalayer.Spec.ServiceAccountName != nil && *datalayer.Spec.ServiceAccountName != "" {
+		deploy.Spec.Template.Spec.ServiceAccountName = *datalayer.Spec.ServiceAccountName
+	}
+
+	if datalayer.Spec.ImagePullSecrets != nil && len(*datalayer.Spec.ImagePullSecrets) != 0 {
+		deploy.Spec.Template.Spec.ImagePullSecrets = *datalayer.Spec.ImagePullSecrets
+	}
+
+	if datalayer.Spec.PodSecurityContext != nil {
+		deploy.Spec.Template.Spec.SecurityContext = datalayer.Spec.PodSecurityContext
+	}
+
+	return deploy
+}
+
+// AssembleHorizontalPodAutoscaler assembles the HorizontalPodAutoscaler resource for the fileserver's own Deployment
+func AssembleHorizontalPodAutoscaler(datalayer k8schianetv1.ChiaDataLayer) autoscalingv2.HorizontalPodAutoscaler {
+	inputs := kube.AssembleHorizontalPodAutoscalerInputs{
+		Name:           fmt.Sprintf(chiadatalayerfileserverNamePattern, datalayer.Name),
+		Namespace:      datalayer.Namespace,
+		Labels:         getLabels(datalayer, datalayer.Spec.Labels),
+		Annotations:    datalayer.Spec.Annotations,
+		DeploymentName: fmt.Sprintf(chiadatalayerfileserverNamePattern, datalayer.Name),
+	}
+
+	if datalayer.Spec.FileserverConfig.Autoscaling != nil {
+		inputs.MinReplicas = datalayer.Spec.FileserverConfig.Autoscaling.MinReplicas
+		inputs.MaxReplicas = datalayer.Spec.FileserverConfig.Autoscaling.MaxReplicas
+		inputs.Metrics = datalayer.Spec.FileserverConfig.Autoscaling.Metrics
+		inputs.Behavior = datalayer.Spec.FileserverConfig.Autoscaling.Behavior
+	}
+
+	return kube.AssembleHorizontalPodAutoscaler(inputs)
+}
+
+// AssemblePodDisruptionBudget assembles the PodDisruptionBudget resource for the fileserver's own Deployment
+func AssemblePodDisruptionBudget(datalayer k8schianetv1.ChiaDataLayer) policyv1.PodDisruptionBudget {
+	inputs := kube.AssemblePodDisruptionBudgetInputs{
+		Name:           fmt.Sprintf(chiadatalayerfileserverNamePattern, datalayer.Name),
+		Namespace:      datalayer.Namespace,
+		Labels:         getLabels(datalayer, datalayer.Spec.Labels),
+		Annotations:    datalayer.Spec.Annotations,
+		SelectorLabels: getLabels(datalayer),
+	}
+
+	if datalayer.Spec.FileserverConfig.PodDisruptionBudget != nil {
+		inputs.MinAvailable = datalayer.Spec.FileserverConfig.PodDisruptionBudget.MinAvailable
+		inputs.MaxUnavailable = datalayer.Spec.FileserverConfig.PodDisruptionBudget.MaxUnavailable
+	}
+
+	return kube.AssemblePodDisruptionBudget(inputs)
+}
+
 // AssembleIngress assembles the fileserver Ingress resource for a ChiaDataLayer CR
 func AssembleIngress(datalayer k8schianetv1.ChiaDataLayer) networkingv1.Ingress {
 	ingress := networkingv1.Ingress{
@@ -293,3 +293,61 @@ func TestAssembleIngress(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldRunSeparately(t *testing.T) {
+	datalayer := func(fileserver k8schianetv1.FileserverConfig) k8schianetv1.ChiaDataLayer {
+		return k8schianetv1.ChiaDataLayer{Spec: k8schianetv1.ChiaDataLayerSpec{FileserverConfig: fileserver}}
+	}
+
+	assert.False(t, ShouldRunSeparately(datalayer(k8schianetv1.FileserverConfig{Enabled: boolPtr(true)})), "a fileserver without replicas or autoscaling is a sidecar")
+	assert.False(t, ShouldRunSeparately(datalayer(k8schianetv1.FileserverConfig{Replicas: ptr.To(int32(2))})), "a disabled fileserver doesn't run")
+	assert.False(t, ShouldRunSeparately(datalayer(k8schianetv1.FileserverConfig{Enabled: boolPtr(true), Autoscaling: &k8schianetv1.AutoscalingConfig{Enabled: boolPtr(false)}})))
+	assert.True(t, ShouldRunSeparately(datalayer(k8schianetv1.FileserverConfig{Enabled: boolPtr(true), Replicas: ptr.To(int32(1))})))
+	assert.True(t, ShouldRunSeparately(datalayer(k8schianetv1.FileserverConfig{Enabled: boolPtr(true), Autoscaling: &k8schianetv1.AutoscalingConfig{Enabled: boolPtr(true), MaxReplicas: 3}})))
+}
+
+func TestAssembleDeployment(t *testing.T) {
+	datalayer := k8schianetv1.ChiaDataLayer{
+		TypeMeta: metav1.TypeMeta{Kind: "ChiaDataLayer"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-datalayer",
+			Namespace: "test-namespace",
+		},
+		Spec: k8schianetv1.ChiaDataLayerSpec{
+			CommonSpec: k8schianetv1.CommonSpec{
+				AdditionalMetadata: k8schianetv1.AdditionalMetadata{Labels: map[string]string{"test": "label"}},
+			},
+			FileserverConfig: k8schianetv1.FileserverConfig{
+				Enabled:  boolPtr(true),
+				Replicas: ptr.To(int32(3)),
+			},
+		},
+	}
+	volume := corev1.Volume{
+		Name:         "server",
+		VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "test-datalayer-server"}},
+	}
+
+	deploy := AssembleDeployment(datalayer, volume)
+	assert.Equal(t, "test-datalayer-datalayer-http", deploy.Name)
+	assert.Equal(t, "test-namespace", deploy.Namespace)
+	assert.Equal(t, ptr.To(int32(3)), deploy.Spec.Replicas)
+	assert.Equal(t, []corev1.Volume{volume}, deploy.Spec.Template.Spec.Volumes)
+	if assert.Len(t, deploy.Spec.Template.Spec.Containers, 1) {
+		assert.Equal(t, AssembleContainer(datalayer), deploy.Spec.Template.Spec.Containers[0])
+	}
+	assert.Equal(t, "test-datalayer-datalayer-http", deploy.Spec.Selector.MatchLabels["app.kubernetes.io/instance"], "the fileserver's pods should be an instance of their own")
+	assert.Equal(t, "label", deploy.Spec.Template.Labels["test"])
+
+	// The fileserver Service selects the fileserver's own pods
+	service := AssembleService(datalayer)
+	assert.Equal(t, deploy.Spec.Template.Labels, service.Spec.Selector)
+
+	// A HorizontalPodAutoscaler sets the replicas while autoscaling is enabled
+	datalayer.Spec.FileserverConfig.Autoscaling = &k8schianetv1.AutoscalingConfig{Enabled: boolPtr(true), MaxReplicas: 5}
+	deploy = AssembleDeployment(datalayer, volume)
+	assert.Nil(t, deploy.Spec.Replicas)
+	hpa := AssembleHorizontalPodAutoscaler(datalayer)
+	assert.Equal(t, deploy.Name, hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+}
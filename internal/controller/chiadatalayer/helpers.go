@@ -15,6 +15,7 @@ import (
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/utils/ptr"
 )
 
 // getChiaVolumes retrieves the requisite volumes from the Chia config struct
@@ -58,21 +59,29 @@ func getChiaVolumes(datalayer k8schianetv1.ChiaDataLayer) []corev1.Volume {
 	}
 
 	// data_layer server files volume
-	serverFilesClaimName := fmt.Sprintf(chiadatalayerNamePattern, datalayer.Name) + "-server"
+	v = append(v, getServerFilesVolume(datalayer))
+
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(datalayer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(datalayer.Spec.ChiaConfig.CommonSpecChia, datalayer.Spec.ChiaConfig.CASecretName))
+	}
+
+	return v
+}
+
+// getServerFilesVolume retrieves the data_layer server files volume, which is shared with the fileserver
+func getServerFilesVolume(datalayer k8schianetv1.ChiaDataLayer) corev1.Volume {
 	if kube.ShouldMakeChiaRootVolumeClaim(datalayer.Spec.Storage) {
-		v = append(v, corev1.Volume{
+		return corev1.Volume{
 			Name: "server",
 			VolumeSource: corev1.VolumeSource{
 				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: serverFilesClaimName,
+					ClaimName: fmt.Sprintf(chiadatalayerNamePattern, datalayer.Name) + "-server",
 				},
 			},
-		})
-	} else {
-		v = append(v, getExistingChiaDatalayerServerVolume(datalayer.Spec.Storage))
+		}
 	}
-
-	return v
+	return getExistingChiaDatalayerServerVolume(datalayer.Spec.Storage)
 }
 
 // getChiaVolumeMounts retrieves the requisite volume mounts from the Chia config struct
@@ -99,6 +108,11 @@ func getChiaVolumeMounts(datalayer k8schianetv1.ChiaDataLayer) []corev1.VolumeMo
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(datalayer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	// data_layer server files volume
 	v = append(v, corev1.VolumeMount{
 		Name:      "server",
@@ -145,7 +159,7 @@ func getChiaEnv(ctx context.Context, datalayer k8schianetv1.ChiaDataLayer, netwo
 
 	// trusted_cidrs env var
 	if datalayer.Spec.ChiaConfig.TrustedCIDRs != nil {
-		// TODO should any special CIDR input checking happen here
+		// CIDRs are checked by the validating webhook, when it's enabled
 		cidrs, err := json.Marshal(*datalayer.Spec.ChiaConfig.TrustedCIDRs)
 		if err != nil {
 			logr.Error(err, "given CIDRs could not be marshalled to json. Peer connections that you would expect to be trusted might not be trusted.")
@@ -233,3 +247,8 @@ func getExistingChiaDatalayerServerVolume(storage *k8schianetv1.StorageConfig) c
 		},
 	}
 }
+
+// secretRefs returns the names of the Secrets the ChiaDataLayer's pods reference, including its CA and mnemonic Secrets
+func secretRefs(datalayer k8schianetv1.ChiaDataLayer) []string {
+	return kube.SecretRefs(datalayer.Spec.ChiaConfig.CommonSpecChia, datalayer.Spec.ChiaExporterConfig, ptr.Deref(datalayer.Spec.ChiaConfig.CASecretName, ""), datalayer.Spec.ChiaConfig.SecretKey.Name)
+}
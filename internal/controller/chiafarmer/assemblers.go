@@ -289,7 +289,10 @@ func assembleDeployment(ctx context.Context, farmer k8schianetv1.ChiaFarmer, net
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(farmer.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(farmer.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(farmer.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range farmer.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -318,7 +321,10 @@ func assembleDeployment(ctx context.Context, farmer k8schianetv1.ChiaFarmer, net
 		deploy.Spec.Template.Spec.SecurityContext = farmer.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, farmer.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -344,7 +350,7 @@ func assembleChiaContainer(ctx context.Context, farmer k8schianetv1.ChiaFarmer,
 				Protocol:      "TCP",
 			},
 		},
-		VolumeMounts: getChiaVolumeMounts(),
+		VolumeMounts: getChiaVolumeMounts(farmer),
 	}
 
 	env, err := getChiaEnv(ctx, farmer, networkData)
@@ -11,16 +11,21 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +37,32 @@ type ChiaFarmerReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiafarmers = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaFarmer resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the farmer RPC is queried for the ChiaFarmer status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiafarmers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiafarmers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiafarmers/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaFarmerKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +70,6 @@ func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	var farmer k8schianetv1.ChiaFarmer
 	err := r.Get(ctx, req.NamespacedName, &farmer)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiafarmers[req.String()]
-		if exists {
-			delete(chiafarmers, req.String())
-			metrics.ChiaFarmers.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,77 +77,70 @@ func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiafarmers[req.String()]
-	if !exists {
-		chiafarmers[req.String()] = true
-		metrics.ChiaFarmers.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, farmer.Spec.ChiaConfig.CommonSpecChia, farmer.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &farmer, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(farmer)
 	if err := controllerutil.SetControllerReference(&farmer, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, farmer.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(farmer)
 	if err := controllerutil.SetControllerReference(&farmer, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, farmer.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(farmer)
 	if err := controllerutil.SetControllerReference(&farmer, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, farmer.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(farmer)
 	if err := controllerutil.SetControllerReference(&farmer, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, farmer.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(farmer)
 	if err := controllerutil.SetControllerReference(&farmer, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, farmer.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -145,17 +148,17 @@ func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		pvc, err := assembleVolumeClaim(farmer)
 		if err != nil {
 			r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &farmer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, farmer.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to create farmer PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &farmer, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &farmer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
@@ -163,22 +166,49 @@ func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	deploy, err := assembleDeployment(ctx, farmer, networkData)
 	if err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&farmer, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to assemble farmer Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, farmer.Namespace, &deploy.Spec.Template, secretRefs(farmer), kube.ConfigMapRefs(farmer.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &farmer, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &farmer.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&farmer, corev1.EventTypeWarning, "Failed", "Failed to create farmer Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &farmer, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
+	}
+	if farmer.Status.Recreation != nil {
+		return r.markRecreating(ctx, &farmer, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &farmer, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&farmer, corev1.EventTypeNormal, "Created", "Successfully created ChiaFarmer resources.")
-	farmer.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !farmer.Status.Ready {
+		r.Recorder.Event(&farmer, corev1.EventTypeNormal, "Created", "Successfully created ChiaFarmer resources.")
+	}
+	farmer.Status.Ready = rollout.Ready()
+	farmer.Status.ObservedGeneration = farmer.Generation
+	farmer.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&farmer.Status.Conditions, farmer.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		status, err := r.getFarmingStatus(ctx, farmer, deploy.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaFarmerReconciler ChiaFarmer=%s unable to query farmer RPC", req.NamespacedName))
+		} else {
+			farmer.Status.Farming = status
+		}
+	}
 	err = r.Status().Update(ctx, &farmer)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -188,43 +218,127 @@ func (r *ChiaFarmerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaFarmer's status conditions, and returns the reconcile error
+func (r *ChiaFarmerReconciler) markFailed(ctx context.Context, farmer *k8schianetv1.ChiaFarmer, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaFarmerKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&farmer.Status.Conditions, farmer.Generation, reason, err.Error()) {
+		farmer.Status.ObservedGeneration = farmer.Generation
+		if updateErr := r.Status().Update(ctx, farmer); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaFarmer status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaFarmer's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaFarmerReconciler) markRecreating(ctx context.Context, farmer *k8schianetv1.ChiaFarmer, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&farmer.Status.Conditions, farmer.Generation, "Deployment", *farmer.Status.Recreation)
+	farmer.Status.ObservedGeneration = farmer.Generation
+	if err := r.Status().Update(ctx, farmer); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaFarmerReconciler ChiaFarmer=%s unable to update ChiaFarmer status: %v", client.ObjectKeyFromObject(farmer), err)
+	}
+	return res, nil
+}
+
+// getFarmingStatus queries the farmer RPC of the ChiaFarmer's running pod for its connected harvesters and pools.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaFarmer's CA Secret.
+// Returns nil if the farmer has no running pod.
+func (r *ChiaFarmerReconciler) getFarmingStatus(ctx context.Context, farmer k8schianetv1.ChiaFarmer, selector *metav1.LabelSelector) (*k8schianetv1.ChiaFarmerFarmingStatus, error) {
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, farmer.Namespace, farmer.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, farmer.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	harvesters, err := rpcClient.GetHarvestersSummary(ctx, pods[0].Status.PodIP, consts.FarmerRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaFarmerFarmingStatus{Error: err.Error()}, nil
+	}
+	pools, err := rpcClient.GetPoolState(ctx, pods[0].Status.PodIP, consts.FarmerRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaFarmerFarmingStatus{Error: err.Error()}, nil
+	}
+
+	return farmingStatus(harvesters, pools), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaFarmerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaFarmers by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaFarmer{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaFarmer))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaFarmer{}).
+		For(&k8schianetv1.ChiaFarmer{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaFarmers that use the ChiaNetwork
 func (r *ChiaFarmerReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaFarmerList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
 	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaFarmers that reference it
+func (r *ChiaFarmerReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaFarmerList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
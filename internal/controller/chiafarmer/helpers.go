@@ -10,10 +10,12 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // getChiaVolumes retrieves the requisite volumes from the Chia config struct
@@ -54,25 +56,42 @@ func getChiaVolumes(farmer k8schianetv1.ChiaFarmer) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(farmer.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(farmer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(farmer.Spec.ChiaConfig.CommonSpecChia, &farmer.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
 // getChiaVolumeMounts retrieves the requisite volume mounts from the Chia config struct
-func getChiaVolumeMounts() []corev1.VolumeMount {
-	return []corev1.VolumeMount{
-		{
-			Name:      "secret-ca",
-			MountPath: "/chia-ca",
-		},
-		{
-			Name:      "key",
-			MountPath: "/key",
-		},
-		{
-			Name:      "chiaroot",
-			MountPath: "/chia-data",
-		},
+func getChiaVolumeMounts(farmer k8schianetv1.ChiaFarmer) []corev1.VolumeMount {
+	var v []corev1.VolumeMount
+
+	// secret ca volume
+	v = append(v, corev1.VolumeMount{
+		Name:      "secret-ca",
+		MountPath: "/chia-ca",
+	})
+
+	// key volume
+	v = append(v, corev1.VolumeMount{
+		Name:      "key",
+		MountPath: "/key",
+	})
+
+	// CHIA_ROOT volume
+	v = append(v, corev1.VolumeMount{
+		Name:      "chiaroot",
+		MountPath: "/chia-data",
+	})
+
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(farmer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
 	}
+
+	return v
 }
 
 // getChiaEnv retrieves the environment variables from the Chia config struct
@@ -119,3 +138,66 @@ func getChiaEnv(ctx context.Context, farmer k8schianetv1.ChiaFarmer, networkData
 
 	return env, nil
 }
+
+// farmingStatus converts the farmer's RPC responses to its ChiaFarmer status
+func farmingStatus(harvesters []chiarpc.HarvesterSummary, pools []chiarpc.PoolState) *k8schianetv1.ChiaFarmerFarmingStatus {
+	status := k8schianetv1.ChiaFarmerFarmingStatus{
+		ConnectedHarvesters: int32(len(harvesters)),
+	}
+
+	var rawSpace, effectiveSpace uint64
+	for _, harvester := range harvesters {
+		harvesterStatus := k8schianetv1.ChiaFarmerHarvesterStatus{
+			Host:           harvester.Connection.Host,
+			NodeID:         harvester.Connection.NodeID,
+			PlotCount:      int32(harvester.Plots),
+			FailedPlots:    int32(harvester.FailedToOpenFilenames + harvester.NoKeyFilenames),
+			DuplicatePlots: int32(harvester.Duplicates),
+			RawSpace:       chiarpc.SpaceQuantity(harvester.TotalPlotSize),
+			EffectiveSpace: chiarpc.SpaceQuantity(harvester.TotalEffectivePlotSize),
+		}
+		if harvester.LastSyncTime > 0 {
+			harvesterStatus.LastSyncTime = &metav1.Time{Time: chiarpc.UnixTime(harvester.LastSyncTime)}
+		}
+		status.Harvesters = append(status.Harvesters, harvesterStatus)
+
+		status.PlotCount += harvesterStatus.PlotCount
+		status.FailedPlots += harvesterStatus.FailedPlots
+		status.DuplicatePlots += harvesterStatus.DuplicatePlots
+		rawSpace += harvester.TotalPlotSize
+		effectiveSpace += harvester.TotalEffectivePlotSize
+	}
+	status.RawSpace = chiarpc.SpaceQuantity(rawSpace)
+	status.EffectiveSpace = chiarpc.SpaceQuantity(effectiveSpace)
+
+	for _, pool := range pools {
+		poolStatus := k8schianetv1.ChiaFarmerPoolStatus{
+			LauncherID:    pool.PoolConfig.LauncherID,
+			PoolURL:       pool.PoolConfig.PoolURL,
+			PlotCount:     int32(pool.PlotCount),
+			CurrentPoints: int64(pool.CurrentPoints),
+			PoolErrors24h: int32(len(pool.PoolErrors24h)),
+		}
+		if pool.CurrentDifficulty != nil {
+			poolStatus.CurrentDifficulty = int64(*pool.CurrentDifficulty)
+		}
+		for _, point := range pool.PointsFound24h {
+			poolStatus.PointsFound24h += int64(point[1])
+		}
+		for _, point := range pool.PointsAcknowledged24h {
+			poolStatus.PointsAcknowledged24h += int64(point[1])
+		}
+		status.Pools = append(status.Pools, poolStatus)
+
+		if last := pool.LastPointFound(); !last.IsZero() && (status.LastProofTime == nil || last.After(status.LastProofTime.Time)) {
+			status.LastProofTime = &metav1.Time{Time: last}
+		}
+	}
+
+	return &status
+}
+
+// secretRefs returns the names of the Secrets the ChiaFarmer's pods reference, including its CA and mnemonic Secrets
+func secretRefs(farmer k8schianetv1.ChiaFarmer) []string {
+	return kube.SecretRefs(farmer.Spec.ChiaConfig.CommonSpecChia, farmer.Spec.ChiaExporterConfig, farmer.Spec.ChiaConfig.CASecretName, farmer.Spec.ChiaConfig.SecretKey.Name)
+}
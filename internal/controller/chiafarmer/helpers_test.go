@@ -2,16 +2,18 @@ package chiafarmer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 )
 
 func TestGetChiaVolumeMounts(t *testing.T) {
-	volumeMounts := getChiaVolumeMounts()
+	volumeMounts := getChiaVolumeMounts(k8schianetv1.ChiaFarmer{})
 
 	assert.Len(t, volumeMounts, 3, "Expected 3 volume mounts")
 
@@ -31,6 +33,28 @@ func TestGetChiaVolumeMounts(t *testing.T) {
 	}
 }
 
+func TestGetChiaVolumeMounts_Certificates(t *testing.T) {
+	certsSecret := "test-certs"
+	farmer := k8schianetv1.ChiaFarmer{
+		Spec: k8schianetv1.ChiaFarmerSpec{
+			ChiaConfig: k8schianetv1.ChiaFarmerSpecChia{
+				CASecretName: "test-ca",
+				CommonSpecChia: k8schianetv1.CommonSpecChia{
+					CertificatesSecretName: &certsSecret,
+				},
+			},
+		},
+	}
+
+	volumeMounts := getChiaVolumeMounts(farmer)
+	assert.Len(t, volumeMounts, 4, "Expected 4 volume mounts")
+	assert.Equal(t, "chia-certificates", volumeMounts[3].Name)
+	assert.Equal(t, "/chia-certificates", volumeMounts[3].MountPath)
+
+	volumes := getChiaVolumes(farmer)
+	assert.Equal(t, "chia-certificates", volumes[len(volumes)-1].Name)
+}
+
 func TestGetChiaVolumes(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -245,3 +269,65 @@ func TestGetChiaVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestFarmingStatus(t *testing.T) {
+	var harvesterA, harvesterB chiarpc.HarvesterSummary
+	harvesterA.Connection.Host = "10.0.0.5"
+	harvesterA.Plots = 10
+	harvesterA.FailedToOpenFilenames = 1
+	harvesterA.NoKeyFilenames = 1
+	harvesterA.TotalPlotSize = 1000000000000
+	harvesterA.TotalEffectivePlotSize = 2000000000000
+	harvesterA.LastSyncTime = 1700000000
+	harvesterB.Connection.Host = "10.0.0.6"
+	harvesterB.Plots = 5
+	harvesterB.Duplicates = 5
+	harvesterB.TotalPlotSize = 500000000000
+	harvesterB.TotalEffectivePlotSize = 500000000000
+
+	var pool chiarpc.PoolState
+	pool.PoolConfig.LauncherID = "0xlauncher"
+	pool.PlotCount = 15
+	pool.PointsFound24h = [][2]float64{{1700000000, 10}, {1700000600, 20}}
+	pool.PointsAcknowledged24h = [][2]float64{{1700000000, 10}}
+
+	status := farmingStatus([]chiarpc.HarvesterSummary{harvesterA, harvesterB}, []chiarpc.PoolState{pool})
+	assert.Equal(t, int32(2), status.ConnectedHarvesters)
+	assert.Equal(t, int32(15), status.PlotCount)
+	assert.Equal(t, int32(2), status.FailedPlots)
+	assert.Equal(t, int32(5), status.DuplicatePlots)
+	assert.Equal(t, "1500G", status.RawSpace.String())
+	assert.Equal(t, "2500G", status.EffectiveSpace.String())
+	assert.Len(t, status.Harvesters, 2)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), status.Harvesters[0].LastSyncTime.Time)
+	assert.Nil(t, status.Harvesters[1].LastSyncTime)
+	assert.Equal(t, []k8schianetv1.ChiaFarmerPoolStatus{
+		{
+			LauncherID:            "0xlauncher",
+			PlotCount:             15,
+			PointsFound24h:        30,
+			PointsAcknowledged24h: 10,
+		},
+	}, status.Pools)
+	assert.Equal(t, time.Unix(1700000600, 0).UTC(), status.LastProofTime.Time)
+
+	// Solo farming without pools
+	status = farmingStatus(nil, nil)
+	assert.Equal(t, int32(0), status.ConnectedHarvesters)
+	assert.Nil(t, status.LastProofTime)
+}
+
+func TestSecretRefs(t *testing.T) {
+	farmer := k8schianetv1.ChiaFarmer{
+		Spec: k8schianetv1.ChiaFarmerSpec{
+			ChiaConfig: k8schianetv1.ChiaFarmerSpecChia{
+				CASecretName: "ca",
+				SecretKey: k8schianetv1.ChiaSecretKey{
+					Name: "mnemonic",
+					Key:  "key.txt",
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"ca", "mnemonic"}, secretRefs(farmer))
+}
@@ -288,7 +288,10 @@ func assembleDeployment(harvester k8schianetv1.ChiaHarvester, networkData *map[s
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(harvester.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(harvester.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(harvester.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range harvester.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -317,7 +320,10 @@ func assembleDeployment(harvester k8schianetv1.ChiaHarvester, networkData *map[s
 		deploy.Spec.Template.Spec.SecurityContext = harvester.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, harvester.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -11,16 +11,21 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +37,32 @@ type ChiaHarvesterReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiaharvesters = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaHarvester resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the harvester RPC is queried for the ChiaHarvester status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaharvesters,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaharvesters/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaharvesters/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaHarvesterKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +70,6 @@ func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	var harvester k8schianetv1.ChiaHarvester
 	err := r.Get(ctx, req.NamespacedName, &harvester)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiaharvesters[req.String()]
-		if exists {
-			delete(chiaharvesters, req.String())
-			metrics.ChiaHarvesters.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,77 +77,70 @@ func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiaharvesters[req.String()]
-	if !exists {
-		chiaharvesters[req.String()] = true
-		metrics.ChiaHarvesters.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, harvester.Spec.ChiaConfig.CommonSpecChia, harvester.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &harvester, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(harvester)
 	if err := controllerutil.SetControllerReference(&harvester, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, harvester.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(harvester)
 	if err := controllerutil.SetControllerReference(&harvester, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, harvester.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(harvester)
 	if err := controllerutil.SetControllerReference(&harvester, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, harvester.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(harvester)
 	if err := controllerutil.SetControllerReference(&harvester, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, harvester.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(harvester)
 	if err := controllerutil.SetControllerReference(&harvester, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, harvester.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -145,17 +148,17 @@ func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		pvc, err := assembleVolumeClaim(harvester)
 		if err != nil {
 			r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &harvester, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, harvester.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to create harvester PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &harvester, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &harvester, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
@@ -163,22 +166,49 @@ func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	deploy, err := assembleDeployment(harvester, networkData)
 	if err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&harvester, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to assemble harvester Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, harvester.Namespace, &deploy.Spec.Template, secretRefs(harvester), kube.ConfigMapRefs(harvester.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &harvester, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &harvester.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&harvester, corev1.EventTypeWarning, "Failed", "Failed to create harvester Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &harvester, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
+	}
+	if harvester.Status.Recreation != nil {
+		return r.markRecreating(ctx, &harvester, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &harvester, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&harvester, corev1.EventTypeNormal, "Created", "Successfully created ChiaHarvester resources.")
-	harvester.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !harvester.Status.Ready {
+		r.Recorder.Event(&harvester, corev1.EventTypeNormal, "Created", "Successfully created ChiaHarvester resources.")
+	}
+	harvester.Status.Ready = rollout.Ready()
+	harvester.Status.ObservedGeneration = harvester.Generation
+	harvester.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&harvester.Status.Conditions, harvester.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		status, err := r.getPlotsStatus(ctx, harvester, deploy.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaHarvesterReconciler ChiaHarvester=%s unable to query harvester RPC", req.NamespacedName))
+		} else {
+			harvester.Status.Plots = status
+		}
+	}
 	err = r.Status().Update(ctx, &harvester)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -188,43 +218,123 @@ func (r *ChiaHarvesterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaHarvester's status conditions, and returns the reconcile error
+func (r *ChiaHarvesterReconciler) markFailed(ctx context.Context, harvester *k8schianetv1.ChiaHarvester, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaHarvesterKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&harvester.Status.Conditions, harvester.Generation, reason, err.Error()) {
+		harvester.Status.ObservedGeneration = harvester.Generation
+		if updateErr := r.Status().Update(ctx, harvester); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaHarvester status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaHarvester's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaHarvesterReconciler) markRecreating(ctx context.Context, harvester *k8schianetv1.ChiaHarvester, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&harvester.Status.Conditions, harvester.Generation, "Deployment", *harvester.Status.Recreation)
+	harvester.Status.ObservedGeneration = harvester.Generation
+	if err := r.Status().Update(ctx, harvester); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaHarvesterReconciler ChiaHarvester=%s unable to update ChiaHarvester status: %v", client.ObjectKeyFromObject(harvester), err)
+	}
+	return res, nil
+}
+
+// getPlotsStatus queries the harvester RPC of the ChiaHarvester's running pod for the plots it has loaded.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaHarvester's CA Secret.
+// Returns nil if the harvester has no running pod.
+func (r *ChiaHarvesterReconciler) getPlotsStatus(ctx context.Context, harvester k8schianetv1.ChiaHarvester, selector *metav1.LabelSelector) (*k8schianetv1.ChiaHarvesterPlotsStatus, error) {
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, harvester.Namespace, harvester.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, harvester.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	plots, err := rpcClient.GetPlots(ctx, pods[0].Status.PodIP, consts.HarvesterRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaHarvesterPlotsStatus{Error: err.Error()}, nil
+	}
+
+	return plotsStatus(plots), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaHarvesterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaHarvesters by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaHarvester{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaHarvester))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaHarvester{}).
+		For(&k8schianetv1.ChiaHarvester{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaHarvesters that use the ChiaNetwork
 func (r *ChiaHarvesterReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaHarvesterList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
 	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaHarvesters that reference it
+func (r *ChiaHarvesterReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaHarvesterList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
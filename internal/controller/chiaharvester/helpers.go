@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
@@ -80,6 +81,11 @@ func getChiaVolumes(harvester k8schianetv1.ChiaHarvester) []corev1.Volume {
 		}
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(harvester.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(harvester.Spec.ChiaConfig.CommonSpecChia, &harvester.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
@@ -99,6 +105,11 @@ func getChiaVolumeMounts(harvester k8schianetv1.ChiaHarvester) []corev1.VolumeMo
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(harvester.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	// hostPath and PVC plot volumemounts
 	if harvester.Spec.Storage != nil {
 		if harvester.Spec.Storage.Plots != nil {
@@ -175,3 +186,19 @@ func getChiaEnv(harvester k8schianetv1.ChiaHarvester, networkData *map[string]st
 
 	return env, nil
 }
+
+// plotsStatus converts the harvester's get_plots response to its ChiaHarvester status
+func plotsStatus(plots chiarpc.Plots) *k8schianetv1.ChiaHarvesterPlotsStatus {
+	return &k8schianetv1.ChiaHarvesterPlotsStatus{
+		PlotCount:      int32(len(plots.Plots)),
+		FailedPlots:    int32(len(plots.FailedToOpenFilenames)),
+		NotFoundPlots:  int32(len(plots.NotFoundFilenames)),
+		RawSpace:       chiarpc.SpaceQuantity(plots.RawSpace()),
+		EffectiveSpace: chiarpc.SpaceQuantity(plots.EffectiveSpace()),
+	}
+}
+
+// secretRefs returns the names of the Secrets the ChiaHarvester's pods reference, including its CA Secret
+func secretRefs(harvester k8schianetv1.ChiaHarvester) []string {
+	return kube.SecretRefs(harvester.Spec.ChiaConfig.CommonSpecChia, harvester.Spec.ChiaExporterConfig, harvester.Spec.ChiaConfig.CASecretName)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -297,3 +298,22 @@ func TestGetChiaVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestPlotsStatus(t *testing.T) {
+	plots := chiarpc.Plots{
+		Plots: []chiarpc.Plot{
+			{Filename: "a.plot", Size: 32, FileSize: 108000000000},
+			{Filename: "b.plot", Size: 32, FileSize: 85000000000},
+		},
+		FailedToOpenFilenames: []string{"broken.plot"},
+		NotFoundFilenames:     []string{"gone.plot", "missing.plot"},
+	}
+
+	status := plotsStatus(plots)
+	assert.Equal(t, int32(2), status.PlotCount)
+	assert.Equal(t, int32(1), status.FailedPlots)
+	assert.Equal(t, int32(2), status.NotFoundPlots)
+	assert.Equal(t, "193G", status.RawSpace.String())
+	assert.Equal(t, "218G", status.EffectiveSpace.String())
+	assert.Empty(t, status.Error)
+}
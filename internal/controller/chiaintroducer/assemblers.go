@@ -10,7 +10,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -203,6 +205,44 @@ func assembleVolumeClaim(introducer k8schianetv1.ChiaIntroducer) (*corev1.Persis
 	return &pvc, nil
 }
 
+// assemblePodDisruptionBudget assembles the PodDisruptionBudget resource for a ChiaIntroducer CR
+func assemblePodDisruptionBudget(introducer k8schianetv1.ChiaIntroducer) policyv1.PodDisruptionBudget {
+	inputs := kube.AssemblePodDisruptionBudgetInputs{
+		Name:           fmt.Sprintf(chiaintroducerNamePattern, introducer.Name),
+		Namespace:      introducer.Namespace,
+		Labels:         kube.GetCommonLabels(introducer.Kind, introducer.ObjectMeta, introducer.Spec.Labels),
+		Annotations:    introducer.Spec.Annotations,
+		SelectorLabels: kube.GetCommonLabels(introducer.Kind, introducer.ObjectMeta),
+	}
+
+	if introducer.Spec.PodDisruptionBudget != nil {
+		inputs.MinAvailable = introducer.Spec.PodDisruptionBudget.MinAvailable
+		inputs.MaxUnavailable = introducer.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+
+	return kube.AssemblePodDisruptionBudget(inputs)
+}
+
+// assembleHorizontalPodAutoscaler assembles the HorizontalPodAutoscaler resource for a ChiaIntroducer CR
+func assembleHorizontalPodAutoscaler(introducer k8schianetv1.ChiaIntroducer) autoscalingv2.HorizontalPodAutoscaler {
+	inputs := kube.AssembleHorizontalPodAutoscalerInputs{
+		Name:           fmt.Sprintf(chiaintroducerNamePattern, introducer.Name),
+		Namespace:      introducer.Namespace,
+		Labels:         kube.GetCommonLabels(introducer.Kind, introducer.ObjectMeta, introducer.Spec.Labels),
+		Annotations:    introducer.Spec.Annotations,
+		DeploymentName: fmt.Sprintf(chiaintroducerNamePattern, introducer.Name),
+	}
+
+	if introducer.Spec.Autoscaling != nil {
+		inputs.MinReplicas = introducer.Spec.Autoscaling.MinReplicas
+		inputs.MaxReplicas = introducer.Spec.Autoscaling.MaxReplicas
+		inputs.Metrics = introducer.Spec.Autoscaling.Metrics
+		inputs.Behavior = introducer.Spec.Autoscaling.Behavior
+	}
+
+	return kube.AssembleHorizontalPodAutoscaler(inputs)
+}
+
 // assembleDeployment assembles the introducer Deployment resource for a ChiaIntroducer CR
 func assembleDeployment(introducer k8schianetv1.ChiaIntroducer, fullNodePort int32, networkData *map[string]string) (appsv1.Deployment, error) {
 	var deploy = appsv1.Deployment{
@@ -242,7 +282,10 @@ func assembleDeployment(introducer k8schianetv1.ChiaIntroducer, fullNodePort int
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(introducer.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(introducer.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(introducer.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range introducer.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -263,6 +306,11 @@ func assembleDeployment(introducer k8schianetv1.ChiaIntroducer, fullNodePort int
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, assembleChiaExporterContainer(introducer))
 	}
 
+	// A HorizontalPodAutoscaler sets the replicas while autoscaling is enabled, so they're left to it
+	if !kube.ShouldMakeHorizontalPodAutoscaler(introducer.Spec.Autoscaling) {
+		deploy.Spec.Replicas = &introducer.Spec.Replicas
+	}
+
 	if introducer.Spec.Strategy != nil {
 		deploy.Spec.Strategy = *introducer.Spec.Strategy
 	}
@@ -271,7 +319,10 @@ func assembleDeployment(introducer k8schianetv1.ChiaIntroducer, fullNodePort int
 		deploy.Spec.Template.Spec.SecurityContext = introducer.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, introducer.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -11,16 +11,21 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +37,29 @@ type ChiaIntroducerReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiaintroducers = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaIntroducer resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaintroducers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaintroducers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaintroducers/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaIntroducerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaIntroducerKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +67,6 @@ func (r *ChiaIntroducerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	var introducer k8schianetv1.ChiaIntroducer
 	err := r.Get(ctx, req.NamespacedName, &introducer)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiaintroducers[req.String()]
-		if exists {
-			delete(chiaintroducers, req.String())
-			metrics.ChiaIntroducers.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,71 +74,64 @@ func (r *ChiaIntroducerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiaintroducers[req.String()]
-	if !exists {
-		chiaintroducers[req.String()] = true
-		metrics.ChiaIntroducers.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, introducer.Spec.ChiaConfig.CommonSpecChia, introducer.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &introducer, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Get the full_node Port and handle the error one time instead of in every function that needs it
 	fullNodePort, err := kube.GetFullNodePort(introducer.Spec.ChiaConfig.CommonSpecChia, networkData)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err)
+		return r.markFailed(ctx, &introducer, kube.ReasonChiaNetworkFailed, ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err))
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(introducer, fullNodePort)
 	if err := controllerutil.SetControllerReference(&introducer, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, introducer.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(introducer, fullNodePort)
 	if err := controllerutil.SetControllerReference(&introducer, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, introducer.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(introducer)
 	if err := controllerutil.SetControllerReference(&introducer, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, introducer.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(introducer)
 	if err := controllerutil.SetControllerReference(&introducer, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, introducer.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -139,40 +139,89 @@ func (r *ChiaIntroducerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		pvc, err := assembleVolumeClaim(introducer)
 		if err != nil {
 			r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &introducer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, introducer.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to create introducer PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &introducer, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &introducer, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
+	// Assemble PodDisruptionBudget
+	pdb := assemblePodDisruptionBudget(introducer)
+	if err := controllerutil.SetControllerReference(&introducer, &pdb, r.Scheme); err != nil {
+		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &introducer, kube.ReasonPodDisruptionBudgetFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling PodDisruptionBudget: %v", req.NamespacedName, err))
+	}
+	// Reconcile PodDisruptionBudget
+	res, err = kube.ReconcilePodDisruptionBudget(ctx, r.Client, introducer.Spec.PodDisruptionBudget, pdb)
+	if err != nil {
+		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to create introducer PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &introducer, kube.ReasonPodDisruptionBudgetFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+	}
+
+	// Assemble HorizontalPodAutoscaler
+	hpa := assembleHorizontalPodAutoscaler(introducer)
+	if err := controllerutil.SetControllerReference(&introducer, &hpa, r.Scheme); err != nil {
+		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &introducer, kube.ReasonHorizontalPodAutoscalerFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s encountered error assembling HorizontalPodAutoscaler: %v", req.NamespacedName, err))
+	}
+	// Reconcile HorizontalPodAutoscaler
+	res, err = kube.ReconcileHorizontalPodAutoscaler(ctx, r.Client, introducer.Spec.Autoscaling, hpa)
+	if err != nil {
+		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to create introducer HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &introducer, kube.ReasonHorizontalPodAutoscalerFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+	}
+
 	// Assemble Deployment
 	deploy, err := assembleDeployment(introducer, fullNodePort, networkData)
 	if err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&introducer, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to assemble introducer Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, introducer.Namespace, &deploy.Spec.Template, secretRefs(introducer), kube.ConfigMapRefs(introducer.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &introducer, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+	}
+	// Replicas that land on different nodes can't share a claim that only one node can mount at a time
+	if kube.GetMaxReplicas(introducer.Spec.Replicas, introducer.Spec.Autoscaling) > 1 {
+		if err := kube.CheckVolumeClaimsShareable(ctx, r.Client, introducer.Namespace, deploy.Spec.Template.Spec.Volumes); err != nil {
+			r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to scale introducer Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &introducer, kube.ReasonPersistentVolumeClaimFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+		}
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &introducer.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&introducer, corev1.EventTypeWarning, "Failed", "Failed to create introducer Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &introducer, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
+	}
+	if introducer.Status.Recreation != nil {
+		return r.markRecreating(ctx, &introducer, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &introducer, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
 	r.Recorder.Event(&introducer, corev1.EventTypeNormal, "Created", "Successfully created ChiaIntroducer resources.")
-	introducer.Status.Ready = true
+	introducer.Status.Ready = rollout.Ready()
+	introducer.Status.ObservedGeneration = introducer.Generation
+	introducer.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&introducer.Status.Conditions, introducer.Generation, rollout)
 	err = r.Status().Update(ctx, &introducer)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -185,40 +234,96 @@ func (r *ChiaIntroducerReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// markFailed records a subresource that couldn't be reconciled in the ChiaIntroducer's status conditions, and returns the reconcile error
+func (r *ChiaIntroducerReconciler) markFailed(ctx context.Context, introducer *k8schianetv1.ChiaIntroducer, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaIntroducerKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&introducer.Status.Conditions, introducer.Generation, reason, err.Error()) {
+		introducer.Status.ObservedGeneration = introducer.Generation
+		if updateErr := r.Status().Update(ctx, introducer); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaIntroducer status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaIntroducer's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaIntroducerReconciler) markRecreating(ctx context.Context, introducer *k8schianetv1.ChiaIntroducer, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&introducer.Status.Conditions, introducer.Generation, "Deployment", *introducer.Status.Recreation)
+	introducer.Status.ObservedGeneration = introducer.Generation
+	if err := r.Status().Update(ctx, introducer); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaIntroducerReconciler ChiaIntroducer=%s unable to update ChiaIntroducer status: %v", client.ObjectKeyFromObject(introducer), err)
+	}
+	return res, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaIntroducerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaIntroducers by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaIntroducer{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaIntroducer))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8schianetv1.ChiaIntroducer{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaIntroducers that use the ChiaNetwork
 func (r *ChiaIntroducerReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaIntroducerList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaIntroducers that reference it
+func (r *ChiaIntroducerReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaIntroducerList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
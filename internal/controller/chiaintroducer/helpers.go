@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"k8s.io/utils/ptr"
 )
 
 // getChiaVolumes retrieves the requisite volumes from the Chia config struct
@@ -43,6 +44,11 @@ func getChiaVolumes(introducer k8schianetv1.ChiaIntroducer) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(introducer.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(introducer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(introducer.Spec.ChiaConfig.CommonSpecChia, introducer.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
@@ -64,6 +70,11 @@ func getChiaVolumeMounts(introducer k8schianetv1.ChiaIntroducer) []corev1.Volume
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(introducer.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	return v
 }
 
@@ -92,3 +103,8 @@ func getChiaEnv(introducer k8schianetv1.ChiaIntroducer, networkData *map[string]
 
 	return env, nil
 }
+
+// secretRefs returns the names of the Secrets the ChiaIntroducer's pods reference, including its CA Secret
+func secretRefs(introducer k8schianetv1.ChiaIntroducer) []string {
+	return kube.SecretRefs(introducer.Spec.ChiaConfig.CommonSpecChia, introducer.Spec.ChiaExporterConfig, ptr.Deref(introducer.Spec.ChiaConfig.CASecretName, ""))
+}
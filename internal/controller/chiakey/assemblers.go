@@ -0,0 +1,27 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// assembleSecret assembles the Secret containing the mnemonic, in the format a ChiaSecretKey references
+func assembleSecret(key k8schianetv1.ChiaKey, mnemonic string) corev1.Secret {
+	return corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getChiaKeySecretName(key),
+			Namespace: key.Namespace,
+			Labels:    kube.GetCommonLabels(key.Kind, key.ObjectMeta),
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			getChiaKeySecretKey(key): mnemonic,
+		},
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	"github.com/chia-network/chia-operator/internal/metrics"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ChiaKeyReconciler reconciles a ChiaKey object
+type ChiaKeyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles is the number of ChiaKey resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+}
+
+//+kubebuilder:rbac:groups=k8s.chia.net,resources=chiakeys,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=k8s.chia.net,resources=chiakeys/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=k8s.chia.net,resources=chiakeys/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile is invoked on any event to a controlled Kubernetes resource
+func (r *ChiaKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaKeyKind), time.Now())
+
+	log := log.FromContext(ctx)
+	log.Info("Running reconciler...")
+
+	// Get the custom resource
+	var key k8schianetv1.ChiaKey
+	err := r.Get(ctx, req.NamespacedName, &key)
+	if err != nil && errors.IsNotFound(err) {
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "unable to fetch ChiaKey resource")
+		return ctrl.Result{}, err
+	}
+
+	// Check if the mnemonic Secret exists
+	secret, secretExists, err := r.getSecret(ctx, key)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("encountered error querying for existing mnemonic Secret: %v", err)
+	}
+
+	// If the mnemonic Secret doesn't exist, generate a mnemonic and create one.
+	// The Secret isn't owned by the ChiaKey, so deleting the ChiaKey doesn't delete the key.
+	if !secretExists {
+		mnemonic, err := generateMnemonic()
+		if err != nil {
+			r.Recorder.Event(&key, corev1.EventTypeWarning, "Failed", "Failed to generate mnemonic -- Check operator logs.")
+			return ctrl.Result{}, err
+		}
+
+		secret = assembleSecret(key, mnemonic)
+		if err = r.Create(ctx, &secret); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error creating mnemonic Secret \"%s\": %v", secret.Name, err)
+		}
+		r.Recorder.Event(&key, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Successfully created mnemonic Secret in %s/%s", key.Namespace, secret.Name))
+
+		// The Secret returned from Create only contains StringData, so read the mnemonic back into Data for key derivation
+		secret.Data = map[string][]byte{
+			getChiaKeySecretKey(key): []byte(mnemonic),
+		}
+	}
+
+	// An existing mnemonic is never replaced, since that would lose access to anything the key controls
+	keys, err := keysFromSecret(key, secret)
+	if err != nil {
+		log.Info("Mnemonic Secret is invalid", "problem", err.Error())
+		return r.markDegraded(ctx, &key, err)
+	}
+
+	conditionsChanged := kube.SetReadyConditions(&key.Status.Conditions, key.Generation, "SecretValid", "Mnemonic Secret contains a valid mnemonic")
+	if !key.Status.Ready || conditionsChanged || key.Status.ObservedGeneration != key.Generation || !statusMatchesKeys(key.Status, keys) {
+		key.Status.Ready = true
+		key.Status.ObservedGeneration = key.Generation
+		key.Status.Fingerprint = int64(keys.Fingerprint)
+		key.Status.MasterPublicKey = hex.EncodeToString(keys.MasterPublicKey)
+		key.Status.FarmerPublicKey = hex.EncodeToString(keys.FarmerPublicKey)
+		key.Status.PoolPublicKey = hex.EncodeToString(keys.PoolPublicKey)
+		if err = r.Status().Update(ctx, &key); err != nil {
+			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			log.Error(err, "encountered error updating ChiaKey status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// markDegraded sets the ChiaKey's Degraded condition, and marks it not Ready, with the problem found in the mnemonic Secret
+func (r *ChiaKeyReconciler) markDegraded(ctx context.Context, key *k8schianetv1.ChiaKey, problem error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaKeyKind), "secret")
+	changed := kube.SetFailedConditions(&key.Status.Conditions, key.Generation, "InvalidSecret", problem.Error())
+	changed = kube.SetCondition(&key.Status.Conditions, key.Generation, k8schianetv1.ConditionTypeReady, metav1.ConditionFalse, "InvalidSecret", problem.Error()) || changed
+	if !changed && !key.Status.Ready {
+		return ctrl.Result{}, nil
+	}
+
+	r.Recorder.Event(key, corev1.EventTypeWarning, "Degraded", fmt.Sprintf("Mnemonic Secret is invalid: %v", problem))
+	key.Status.Ready = false
+	key.Status.ObservedGeneration = key.Generation
+	if err := r.Status().Update(ctx, key); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		log.FromContext(ctx).Error(err, "encountered error updating ChiaKey status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ChiaKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&k8schianetv1.ChiaKey{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
+		).
+		Complete(r)
+}
+
+// handleSecrets enqueues the ChiaKeys that use a Secret for their mnemonic, so the status is updated when the Secret changes
+func (r *ChiaKeyReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
+	listOps := &client.ListOptions{
+		Namespace: obj.GetNamespace(),
+	}
+	list := &k8schianetv1.ChiaKeyList{}
+	err := r.List(ctx, list, listOps)
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	var requests []reconcile.Request
+	for _, item := range list.Items {
+		if getChiaKeySecretName(item) == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      item.GetName(),
+					Namespace: item.GetNamespace(),
+				},
+			})
+		}
+	}
+	return requests
+}
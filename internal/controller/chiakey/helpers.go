@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// defaultSecretKey is the default key of the data item in the Secret that contains the mnemonic
+const defaultSecretKey = "key.txt"
+
+// getSecret fetches the k8s Secret that matches this ChiaKey. Returns true if the Secret exists.
+func (r *ChiaKeyReconciler) getSecret(ctx context.Context, key k8schianetv1.ChiaKey) (corev1.Secret, bool, error) {
+	var secret corev1.Secret
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: key.Namespace,
+		Name:      getChiaKeySecretName(key),
+	}, &secret)
+	if err != nil && errors.IsNotFound(err) {
+		return corev1.Secret{}, false, nil
+	}
+	if err != nil {
+		return corev1.Secret{}, false, err
+	}
+	return secret, true, nil
+}
+
+// getChiaKeySecretName gets the name of the Secret that contains the mnemonic
+func getChiaKeySecretName(key k8schianetv1.ChiaKey) string {
+	secretName := key.Name
+	if strings.TrimSpace(key.Spec.Secret) != "" {
+		secretName = key.Spec.Secret
+	}
+	return secretName
+}
+
+// getChiaKeySecretKey gets the key of the data item in the Secret that contains the mnemonic
+func getChiaKeySecretKey(key k8schianetv1.ChiaKey) string {
+	if strings.TrimSpace(key.Spec.Key) != "" {
+		return key.Spec.Key
+	}
+	return defaultSecretKey
+}
+
+// keysFromSecret reads the mnemonic from the Secret and derives its public keys
+func keysFromSecret(key k8schianetv1.ChiaKey, secret corev1.Secret) (chiaKeys, error) {
+	mnemonic := strings.TrimSpace(string(secret.Data[getChiaKeySecretKey(key)]))
+	if mnemonic == "" {
+		return chiaKeys{}, fmt.Errorf("secret does not contain a mnemonic in key %s", getChiaKeySecretKey(key))
+	}
+	return keysFromMnemonic(mnemonic)
+}
+
+// statusMatchesKeys returns true if the ChiaKey's status already reports the given keys
+func statusMatchesKeys(status k8schianetv1.ChiaKeyStatus, keys chiaKeys) bool {
+	return status.Fingerprint == int64(keys.Fingerprint) &&
+		status.MasterPublicKey == hex.EncodeToString(keys.MasterPublicKey) &&
+		status.FarmerPublicKey == hex.EncodeToString(keys.FarmerPublicKey) &&
+		status.PoolPublicKey == hex.EncodeToString(keys.PoolPublicKey)
+}
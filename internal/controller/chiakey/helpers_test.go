@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var testChiaKey = k8schianetv1.ChiaKey{
+	TypeMeta: metav1.TypeMeta{
+		Kind:       "ChiaKey",
+		APIVersion: "k8s.chia.net/v1",
+	},
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "testname",
+		Namespace: "testnamespace",
+	},
+}
+
+func TestGetChiaKeySecretName(t *testing.T) {
+	assert.Equal(t, "testname", getChiaKeySecretName(testChiaKey))
+
+	key := testChiaKey
+	key.Spec.Secret = "custom-secret-name"
+	assert.Equal(t, "custom-secret-name", getChiaKeySecretName(key))
+}
+
+func TestAssembleSecret(t *testing.T) {
+	secret := assembleSecret(testChiaKey, "mnemonic")
+	assert.Equal(t, "testname", secret.Name)
+	assert.Equal(t, "testnamespace", secret.Namespace)
+	assert.Equal(t, "mnemonic", secret.StringData["key.txt"])
+
+	key := testChiaKey
+	key.Spec.Key = "mnemonic.txt"
+	secret = assembleSecret(key, "mnemonic")
+	assert.Equal(t, "mnemonic", secret.StringData["mnemonic.txt"])
+}
+
+func TestKeysFromSecret(t *testing.T) {
+	mnemonic, err := generateMnemonic()
+	require.NoError(t, err)
+	expected, err := keysFromMnemonic(mnemonic)
+	require.NoError(t, err)
+
+	// Trailing whitespace from a hand-made key file is ignored
+	keys, err := keysFromSecret(testChiaKey, corev1.Secret{Data: map[string][]byte{"key.txt": []byte(mnemonic + "\n")}})
+	require.NoError(t, err)
+	assert.Equal(t, expected, keys)
+
+	var status k8schianetv1.ChiaKeyStatus
+	assert.False(t, statusMatchesKeys(status, keys))
+
+	_, err = keysFromSecret(testChiaKey, corev1.Secret{})
+	assert.Error(t, err)
+}
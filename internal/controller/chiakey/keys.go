@@ -0,0 +1,149 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// mnemonicEntropyBits is the entropy of a 24 word BIP39 mnemonic
+	mnemonicEntropyBits = 256
+
+	// blsKeyGenSalt is the HKDF salt Chia's BLS implementation uses to derive a private key from a seed
+	blsKeyGenSalt = "BLS-SIG-KEYGEN-SALT-"
+
+	// blsKeyGenLength is the length of the HKDF output that's reduced modulo the curve order to make a private key
+	blsKeyGenLength = 48
+
+	// Chia's derivation path components, see chia/wallet/derive_keys.py
+	chiaBLSPurpose = 12381
+	chiaCoinType   = 8444
+	farmerPath     = 0
+	poolPath       = 1
+)
+
+// chiaKeys is the public information about a Chia key
+type chiaKeys struct {
+	Fingerprint     uint32
+	MasterPublicKey []byte
+	FarmerPublicKey []byte
+	PoolPublicKey   []byte
+}
+
+// generateMnemonic generates a new 24 word BIP39 mnemonic
+func generateMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", fmt.Errorf("error generating mnemonic entropy: %v", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("error generating mnemonic: %v", err)
+	}
+	return mnemonic, nil
+}
+
+// keysFromMnemonic derives the fingerprint and public keys Chia derives for a mnemonic with an empty passphrase
+func keysFromMnemonic(mnemonic string) (chiaKeys, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return chiaKeys{}, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+
+	masterSK, err := keyGen(seed)
+	if err != nil {
+		return chiaKeys{}, err
+	}
+	farmerSK, err := derivePath(masterSK, []uint32{chiaBLSPurpose, chiaCoinType, farmerPath, 0})
+	if err != nil {
+		return chiaKeys{}, err
+	}
+	poolSK, err := derivePath(masterSK, []uint32{chiaBLSPurpose, chiaCoinType, poolPath, 0})
+	if err != nil {
+		return chiaKeys{}, err
+	}
+
+	masterPK := publicKey(masterSK)
+	return chiaKeys{
+		Fingerprint:     fingerprint(masterPK),
+		MasterPublicKey: masterPK,
+		FarmerPublicKey: publicKey(farmerSK),
+		PoolPublicKey:   publicKey(poolSK),
+	}, nil
+}
+
+// keyGen derives a BLS private key from a seed the same way Chia's BLS library does
+func keyGen(seed []byte) (*big.Int, error) {
+	ikm := append(append([]byte{}, seed...), 0)
+	info := []byte{0, blsKeyGenLength}
+	okm := make([]byte, blsKeyGenLength)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, []byte(blsKeyGenSalt), info), okm); err != nil {
+		return nil, fmt.Errorf("error deriving private key: %v", err)
+	}
+	sk := new(big.Int).SetBytes(okm)
+	return sk.Mod(sk, bls12381.NewG1().Q()), nil
+}
+
+// deriveChildSK derives a hardened child private key with the lamport based scheme from EIP-2333
+func deriveChildSK(parent *big.Int, index uint32) (*big.Int, error) {
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+
+	ikm := make([]byte, sha256.Size)
+	parent.FillBytes(ikm)
+	notIKM := make([]byte, sha256.Size)
+	for i := range ikm {
+		notIKM[i] = ikm[i] ^ 0xff
+	}
+
+	lamportPK := sha256.New()
+	for _, material := range [][]byte{ikm, notIKM} {
+		lamportSK := make([]byte, sha256.Size*255)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, material, salt, nil), lamportSK); err != nil {
+			return nil, fmt.Errorf("error deriving child key: %v", err)
+		}
+		for i := 0; i < 255; i++ {
+			chunk := sha256.Sum256(lamportSK[i*sha256.Size : (i+1)*sha256.Size])
+			lamportPK.Write(chunk[:])
+		}
+	}
+
+	return keyGen(lamportPK.Sum(nil))
+}
+
+// derivePath derives a hardened child private key for each index in the path
+func derivePath(sk *big.Int, path []uint32) (*big.Int, error) {
+	var err error
+	for _, index := range path {
+		sk, err = deriveChildSK(sk, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sk, nil
+}
+
+// publicKey returns the compressed G1 public key for a private key
+func publicKey(sk *big.Int) []byte {
+	g1 := bls12381.NewG1()
+	pk := g1.New()
+	g1.MulScalarBig(pk, g1.One(), sk)
+	return g1.ToCompressed(pk)
+}
+
+// fingerprint returns the fingerprint of a public key, the first 4 bytes of its SHA-256 hash
+func fingerprint(pk []byte) uint32 {
+	hash := sha256.Sum256(pk)
+	return binary.BigEndian.Uint32(hash[:4])
+}
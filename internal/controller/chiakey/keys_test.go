@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiakey
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from Chia's BLS signature library
+func TestKeyGen(t *testing.T) {
+	sk, err := keyGen(bytes.Repeat([]byte{0x08}, 32))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0x8ee7ba56), fingerprint(publicKey(sk)))
+}
+
+func TestDeriveChildSK(t *testing.T) {
+	seed, err := hex.DecodeString("3141592653589793238462643383279502884197169399375105820974944592")
+	require.NoError(t, err)
+
+	master, err := keyGen(seed)
+	require.NoError(t, err)
+	assert.Equal(t, "4ff5e145590ed7b71e577bb04032396d1619ff41cb4e350053ed2dce8d1efd1c", hex.EncodeToString(master.FillBytes(make([]byte, 32))))
+
+	child, err := deriveChildSK(master, 3141592653)
+	require.NoError(t, err)
+	assert.Equal(t, "5c62dcf9654481292aafa3348f1d1b0017bbfb44d6881d26d2b17836b38f204d", hex.EncodeToString(child.FillBytes(make([]byte, 32))))
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	mnemonic, err := generateMnemonic()
+	require.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+
+	keys, err := keysFromMnemonic(mnemonic)
+	require.NoError(t, err)
+	assert.Len(t, keys.MasterPublicKey, 48)
+	assert.Len(t, keys.FarmerPublicKey, 48)
+	assert.Len(t, keys.PoolPublicKey, 48)
+	assert.NotEqual(t, keys.MasterPublicKey, keys.FarmerPublicKey)
+	assert.NotEqual(t, keys.FarmerPublicKey, keys.PoolPublicKey)
+}
+
+func TestKeysFromMnemonic_Invalid(t *testing.T) {
+	_, err := keysFromMnemonic("not a valid mnemonic")
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1 "github.com/chia-network/chia-operator/api/v1"
+)
+
+var _ = Describe("ChiaKey controller", func() {
+	var (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	Context("When creating ChiaKey", func() {
+		It("should generate a mnemonic Secret and publish its public keys", func() {
+			By("By creating a new ChiaKey")
+			ctx := context.Background()
+			testKey := &apiv1.ChiaKey{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "k8s.chia.net/v1",
+					Kind:       "ChiaKey",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-chiakey",
+					Namespace: "default",
+				},
+				Spec: apiv1.ChiaKeySpec{
+					Secret: "test-chiakey-secret",
+				},
+			}
+
+			// Create ChiaKey
+			Expect(k8sClient.Create(ctx, testKey)).Should(Succeed())
+
+			// Look up the generated mnemonic Secret
+			secret := &corev1.Secret{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-chiakey-secret", Namespace: "default"}, secret)
+				return err == nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(strings.Fields(string(secret.Data["key.txt"]))).Should(HaveLen(24))
+
+			// Ensure the ChiaKey's status contains the public keys
+			lookupKey := types.NamespacedName{Name: testKey.Name, Namespace: testKey.Namespace}
+			createdChiaKey := &apiv1.ChiaKey{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, lookupKey, createdChiaKey)
+				return err == nil && createdChiaKey.Status.Ready
+			}, timeout, interval).Should(BeTrue())
+			Expect(createdChiaKey.Status.Fingerprint).ShouldNot(BeZero())
+			Expect(createdChiaKey.Status.MasterPublicKey).Should(HaveLen(96))
+			Expect(createdChiaKey.Status.FarmerPublicKey).Should(HaveLen(96))
+			Expect(createdChiaKey.Status.PoolPublicKey).Should(HaveLen(96))
+		})
+	})
+})
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
-	"github.com/chia-network/chia-operator/internal/metrics"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
@@ -20,9 +19,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"github.com/chia-network/chia-operator/internal/metrics"
 )
 
 // ChiaNetworkReconciler reconciles a ChiaNetwork object
@@ -30,17 +32,20 @@ type ChiaNetworkReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chianetworks = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaNetwork resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+}
 
 // +kubebuilder:rbac:groups=k8s.chia.net,resources=chianetworks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=k8s.chia.net,resources=chianetworks/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=k8s.chia.net,resources=chianetworks/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaNetworkKind), time.Now())
+
 	klog := log.FromContext(ctx)
 	klog.Info("Running reconciler...")
 
@@ -48,12 +53,6 @@ func (r *ChiaNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	var network k8schianetv1.ChiaNetwork
 	err := r.Get(ctx, req.NamespacedName, &network)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chianetworks[req.String()]
-		if exists {
-			delete(chianetworks, req.String())
-			metrics.ChiaNetworks.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -61,36 +60,33 @@ func (r *ChiaNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chianetworks[req.String()]
-	if !exists {
-		chianetworks[req.String()] = true
-		metrics.ChiaNetworks.Add(1.0)
-	}
-
 	// Assemble configmap
 	configmap, err := assembleConfigMap(network)
 	if err != nil {
 		r.Recorder.Event(&network, corev1.EventTypeWarning, "Failed", "Failed to assemble network ConfigMap -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error assembling network ConfigMap: %v", err)
+		return r.markFailed(ctx, &network, kube.ReasonConfigMapFailed, ctrl.Result{}, fmt.Errorf("encountered error assembling network ConfigMap: %v", err))
 	}
 	if err := controllerutil.SetControllerReference(&network, &configmap, r.Scheme); err != nil {
 		r.Recorder.Event(&network, corev1.EventTypeWarning, "Failed", "Failed to set controller reference on network ConfigMap -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("encountered error setting controller reference on network ConfigMap: %v", err)
+		return r.markFailed(ctx, &network, kube.ReasonConfigMapFailed, ctrl.Result{}, fmt.Errorf("encountered error setting controller reference on network ConfigMap: %v", err))
 	}
 
 	// Reconcile configmap
 	res, err := kube.ReconcileConfigMap(ctx, r.Client, configmap)
 	if err != nil {
 		r.Recorder.Event(&network, corev1.EventTypeWarning, "Failed", "Failed to reconcile network ConfigMap -- Check operator logs.")
-		return res, fmt.Errorf("encountered error reconciling network ConfigMap: %v", err)
+		return r.markFailed(ctx, &network, kube.ReasonConfigMapFailed, res, fmt.Errorf("encountered error reconciling network ConfigMap: %v", err))
 	}
 
-	if !network.Status.Ready {
-		r.Recorder.Event(&network, corev1.EventTypeNormal, "Created",
-			fmt.Sprintf("Successfully created network ConfigMap in %s/%s", network.Namespace, network.Name))
+	conditionsChanged := kube.SetReadyConditions(&network.Status.Conditions, network.Generation, kube.ReasonReconciled, "Successfully reconciled ChiaNetwork resources")
+	if !network.Status.Ready || conditionsChanged || network.Status.ObservedGeneration != network.Generation {
+		if !network.Status.Ready {
+			r.Recorder.Event(&network, corev1.EventTypeNormal, "Created",
+				fmt.Sprintf("Successfully created network ConfigMap in %s/%s", network.Namespace, network.Name))
+		}
 
 		network.Status.Ready = true
+		network.Status.ObservedGeneration = network.Generation
 		err = r.Status().Update(ctx, &network)
 		if err != nil {
 			if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -104,9 +100,22 @@ func (r *ChiaNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
+// markFailed records a subresource that couldn't be reconciled in the ChiaNetwork's status conditions, and returns the reconcile error
+func (r *ChiaNetworkReconciler) markFailed(ctx context.Context, network *k8schianetv1.ChiaNetwork, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaNetworkKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&network.Status.Conditions, network.Generation, reason, err.Error()) {
+		network.Status.ObservedGeneration = network.Generation
+		if updateErr := r.Status().Update(ctx, network); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaNetwork status")
+		}
+	}
+	return res, err
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8schianetv1.ChiaNetwork{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Complete(r)
 }
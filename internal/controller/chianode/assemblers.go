@@ -10,6 +10,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -278,6 +279,24 @@ func assembleLocalPeerService(node k8schianetv1.ChiaNode, fullNodePort int32) co
 	return srv
 }
 
+// assemblePodDisruptionBudget assembles the PodDisruptionBudget resource for a ChiaNode CR
+func assemblePodDisruptionBudget(node k8schianetv1.ChiaNode) policyv1.PodDisruptionBudget {
+	inputs := kube.AssemblePodDisruptionBudgetInputs{
+		Name:           fmt.Sprintf(chianodeNamePattern, node.Name),
+		Namespace:      node.Namespace,
+		Labels:         kube.GetCommonLabels(node.Kind, node.ObjectMeta, node.Spec.Labels),
+		Annotations:    node.Spec.Annotations,
+		SelectorLabels: kube.GetCommonLabels(node.Kind, node.ObjectMeta),
+	}
+
+	if node.Spec.PodDisruptionBudget != nil {
+		inputs.MinAvailable = node.Spec.PodDisruptionBudget.MinAvailable
+		inputs.MaxUnavailable = node.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+
+	return kube.AssemblePodDisruptionBudget(inputs)
+}
+
 // assembleStatefulset assembles the node StatefulSet resource for a ChiaNode CR
 func assembleStatefulset(ctx context.Context, node k8schianetv1.ChiaNode, fullNodePort int32, networkData *map[string]string) (appsv1.StatefulSet, error) {
 	vols, volClaimTemplates := getChiaVolumesAndTemplates(node)
@@ -322,7 +341,10 @@ func assembleStatefulset(ctx context.Context, node k8schianetv1.ChiaNode, fullNo
 	stateful.Spec.Template.Spec.Containers = append(stateful.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	stateful.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(node.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(node.Spec.ChiaConfig.CommonSpecChia) {
+		stateful.Spec.Template.Spec.InitContainers = append(stateful.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	stateful.Spec.Template.Spec.InitContainers = append(stateful.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(node.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range node.Spec.InitContainers {
 		stateful.Spec.Template.Spec.Volumes = append(stateful.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -355,7 +377,10 @@ func assembleStatefulset(ctx context.Context, node k8schianetv1.ChiaNode, fullNo
 		stateful.Spec.Template.Spec.SecurityContext = node.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&stateful.Spec.Template, node.Spec.PodTemplate); err != nil {
+		return appsv1.StatefulSet{}, err
+	}
 
 	return stateful, nil
 }
@@ -381,7 +406,7 @@ func assembleChiaContainer(ctx context.Context, node k8schianetv1.ChiaNode, full
 				Protocol:      "TCP",
 			},
 		},
-		VolumeMounts: getChiaVolumeMounts(),
+		VolumeMounts: getChiaVolumeMounts(node),
 	}
 
 	env, err := getChiaEnv(ctx, node, networkData)
@@ -8,19 +8,26 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +39,32 @@ type ChiaNodeReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chianodes = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaNode resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the full_node RPC of each pod is queried for the ChiaNode status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chianodes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chianodes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chianodes/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaNodeKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +72,6 @@ func (r *ChiaNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	var node k8schianetv1.ChiaNode
 	err := r.Get(ctx, req.NamespacedName, &node)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chianodes[req.String()]
-		if exists {
-			delete(chianodes, req.String())
-			metrics.ChiaNodes.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,143 +79,176 @@ func (r *ChiaNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chianodes[req.String()]
-	if !exists {
-		chianodes[req.String()] = true
-		metrics.ChiaNodes.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, node.Spec.ChiaConfig.CommonSpecChia, node.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &node, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Get the full_node Port and handle the error one time instead of in every function that needs it
 	fullNodePort, err := kube.GetFullNodePort(node.Spec.ChiaConfig.CommonSpecChia, networkData)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err)
+		return r.markFailed(ctx, &node, kube.ReasonChiaNetworkFailed, ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err))
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(node, fullNodePort)
 	if err := controllerutil.SetControllerReference(&node, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(node, fullNodePort)
 	if err := controllerutil.SetControllerReference(&node, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Headless Peer Service
 	headlessPeerSrv := assembleHeadlessPeerService(node, fullNodePort)
 	if err := controllerutil.SetControllerReference(&node, &headlessPeerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node headless peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling headless peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling headless peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Headless Peer Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.PeerService, headlessPeerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Local Peer Service
 	localPeerSrv := assembleLocalPeerService(node, fullNodePort)
 	if err := controllerutil.SetControllerReference(&node, &localPeerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node local peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling local peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling local peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Local Peer Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.PeerService, localPeerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(node)
 	if err := controllerutil.SetControllerReference(&node, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(node)
 	if err := controllerutil.SetControllerReference(&node, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(node)
 	if err := controllerutil.SetControllerReference(&node, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Healthcheck Service
 	healthcheckSrv := assembleChiaHealthcheckService(node)
 	if err := controllerutil.SetControllerReference(&node, &healthcheckSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node chia-healthcheck Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling chia-healthcheck Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling chia-healthcheck Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Healthcheck Service
 	if !kube.ShouldRollIntoMainPeerService(node.Spec.ChiaHealthcheckConfig.Service) {
 		res, err = kube.ReconcileService(ctx, r.Client, node.Spec.ChiaHealthcheckConfig.Service, healthcheckSrv, false)
 		if err != nil {
-			return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &node, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 		}
 	}
 
+	// Assemble PodDisruptionBudget
+	pdb := assemblePodDisruptionBudget(node)
+	if err := controllerutil.SetControllerReference(&node, &pdb, r.Scheme); err != nil {
+		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &node, kube.ReasonPodDisruptionBudgetFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s encountered error assembling PodDisruptionBudget: %v", req.NamespacedName, err))
+	}
+	// Reconcile PodDisruptionBudget
+	res, err = kube.ReconcilePodDisruptionBudget(ctx, r.Client, node.Spec.PodDisruptionBudget, pdb)
+	if err != nil {
+		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to create node PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &node, kube.ReasonPodDisruptionBudgetFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
+	}
+
 	// Assemble StatefulSet
 	stateful, err := assembleStatefulset(ctx, node, fullNodePort, networkData)
 	if err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble node Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonStatefulSetFailed, reconcile.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&node, &stateful, r.Scheme); err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder Statefulset -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonStatefulSetFailed, reconcile.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, node.Namespace, &stateful.Spec.Template, secretRefs(node), kube.ConfigMapRefs(node.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &node, kube.ReasonStatefulSetFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 	// Reconcile StatefulSet
-	res, err = kube.ReconcileStatefulset(ctx, r.Client, stateful)
+	res, err = kube.ReconcileStatefulset(ctx, r.Client, stateful, &node.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&node, corev1.EventTypeWarning, "Failed", "Failed to create seeder Statefulset -- Check operator logs.")
-		return res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &node, kube.ReasonStatefulSetFailed, res, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
+	}
+	if node.Status.Recreation != nil {
+		return r.markRecreating(ctx, &node, res)
+	}
+
+	// Get the StatefulSet's rollout, which the CR status is derived from
+	rollout, err := kube.GetStatefulSetRollout(ctx, r.Client, types.NamespacedName{Namespace: stateful.Namespace, Name: stateful.Name})
+	if err != nil {
+		return r.markFailed(ctx, &node, kube.ReasonStatefulSetFailed, ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&node, corev1.EventTypeNormal, "Created", "Successfully created ChiaNode resources.")
-	node.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !node.Status.Ready {
+		r.Recorder.Event(&node, corev1.EventTypeNormal, "Created", "Successfully created ChiaNode resources.")
+	}
+	node.Status.Ready = rollout.Ready()
+	node.Status.ObservedGeneration = node.Generation
+	node.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&node.Status.Conditions, node.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		fullNodes, err := r.getFullNodeStatuses(ctx, node, stateful.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaNodeReconciler ChiaNode=%s unable to query full_node RPC", req.NamespacedName))
+		} else {
+			node.Status.FullNodes = fullNodes
+		}
+	}
 	err = r.Status().Update(ctx, &node)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -213,43 +258,136 @@ func (r *ChiaNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaNode's status conditions, and returns the reconcile error
+func (r *ChiaNodeReconciler) markFailed(ctx context.Context, node *k8schianetv1.ChiaNode, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaNodeKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&node.Status.Conditions, node.Generation, reason, err.Error()) {
+		node.Status.ObservedGeneration = node.Generation
+		if updateErr := r.Status().Update(ctx, node); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaNode status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaNode's StatefulSet in its status, and requeues the reconcile to carry on with it
+func (r *ChiaNodeReconciler) markRecreating(ctx context.Context, node *k8schianetv1.ChiaNode, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&node.Status.Conditions, node.Generation, "StatefulSet", *node.Status.Recreation)
+	node.Status.ObservedGeneration = node.Generation
+	if err := r.Status().Update(ctx, node); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaNodeReconciler ChiaNode=%s unable to update ChiaNode status: %v", client.ObjectKeyFromObject(node), err)
+	}
+	return res, nil
+}
+
+// getFullNodeStatuses queries the full_node RPC of each of the ChiaNode's running pods for its blockchain state.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaNode's CA Secret.
+func (r *ChiaNodeReconciler) getFullNodeStatuses(ctx context.Context, node k8schianetv1.ChiaNode, selector *metav1.LabelSelector) ([]k8schianetv1.ChiaNodeFullNodeStatus, error) {
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, node.Namespace, node.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, node.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+
+	statuses := make([]k8schianetv1.ChiaNodeFullNodeStatus, len(pods))
+	var wg sync.WaitGroup
+	for i, pod := range pods {
+		wg.Add(1)
+		go func(i int, pod corev1.Pod) {
+			defer wg.Done()
+			state, err := rpcClient.GetBlockchainState(ctx, pod.Status.PodIP, consts.NodeRPCPort)
+			if err != nil {
+				statuses[i] = k8schianetv1.ChiaNodeFullNodeStatus{Pod: pod.Name, Error: err.Error()}
+				return
+			}
+			connections, err := rpcClient.GetConnections(ctx, pod.Status.PodIP, consts.NodeRPCPort)
+			if err != nil {
+				statuses[i] = k8schianetv1.ChiaNodeFullNodeStatus{Pod: pod.Name, Error: err.Error()}
+				return
+			}
+			statuses[i] = fullNodeStatus(pod.Name, state, connections)
+		}(i, pod)
+	}
+	wg.Wait()
+
+	return statuses, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaNodes by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaNode{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaNode))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaNode{}).
+		For(&k8schianetv1.ChiaNode{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaNodes that use the ChiaNetwork
 func (r *ChiaNodeReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaNodeList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
 	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaNodes that reference it
+func (r *ChiaNodeReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaNodeList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
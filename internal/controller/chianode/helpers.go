@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -42,6 +43,11 @@ func getChiaVolumesAndTemplates(node k8schianetv1.ChiaNode) ([]corev1.Volume, []
 		v = append(v, *rootVol)
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(node.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(node.Spec.ChiaConfig.CommonSpecChia, &node.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v, vcts
 }
 
@@ -96,7 +102,7 @@ func getChiaRootVolume(storage *k8schianetv1.StorageConfig) (*corev1.Volume, *co
 }
 
 // getChiaVolumeMounts retrieves the requisite volume mounts from the Chia config struct
-func getChiaVolumeMounts() []corev1.VolumeMount {
+func getChiaVolumeMounts(node k8schianetv1.ChiaNode) []corev1.VolumeMount {
 	var v []corev1.VolumeMount
 
 	// secret ca volume
@@ -111,6 +117,11 @@ func getChiaVolumeMounts() []corev1.VolumeMount {
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(node.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	return v
 }
 
@@ -146,7 +157,7 @@ func getChiaEnv(ctx context.Context, node k8schianetv1.ChiaNode, networkData *ma
 
 	// trusted_cidrs env var
 	if node.Spec.ChiaConfig.TrustedCIDRs != nil {
-		// TODO should any special CIDR input checking happen here
+		// CIDRs are checked by the validating webhook, when it's enabled
 		cidrs, err := json.Marshal(*node.Spec.ChiaConfig.TrustedCIDRs)
 		if err != nil {
 			logr.Error(err, fmt.Sprintf("ChiaNodeReconciler ChiaNode=%s given CIDRs could not be marshalled to json. Peer connections that you would expect to be trusted might not be trusted.", node.Name))
@@ -167,3 +178,22 @@ func getChiaEnv(ctx context.Context, node k8schianetv1.ChiaNode, networkData *ma
 
 	return env, nil
 }
+
+// fullNodeStatus converts a pod's full_node RPC responses to its ChiaNode status
+func fullNodeStatus(pod string, state chiarpc.BlockchainState, connections []chiarpc.Connection) k8schianetv1.ChiaNodeFullNodeStatus {
+	return k8schianetv1.ChiaNodeFullNodeStatus{
+		Pod:                pod,
+		PeakHeight:         int64(state.PeakHeight()),
+		Synced:             state.Sync.Synced,
+		SyncMode:           state.Sync.SyncMode,
+		SyncProgressHeight: int64(state.Sync.SyncProgressHeight),
+		SyncTipHeight:      int64(state.Sync.SyncTipHeight),
+		Difficulty:         int64(state.Difficulty),
+		Connections:        int32(len(connections)),
+	}
+}
+
+// secretRefs returns the names of the Secrets the ChiaNode's pods reference, including its CA Secret
+func secretRefs(node k8schianetv1.ChiaNode) []string {
+	return kube.SecretRefs(node.Spec.ChiaConfig.CommonSpecChia, node.Spec.ChiaExporterConfig, node.Spec.ChiaConfig.CASecretName)
+}
@@ -9,10 +9,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 )
 
 func TestGetChiaVolumeMounts(t *testing.T) {
-	volumeMounts := getChiaVolumeMounts()
+	volumeMounts := getChiaVolumeMounts(k8schianetv1.ChiaNode{})
 
 	assert.Len(t, volumeMounts, 2, "Expected 2 volume mounts")
 
@@ -280,3 +281,22 @@ func TestGetChiaVolumesAndTemplates(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestFullNodeStatus(t *testing.T) {
+	var state chiarpc.BlockchainState
+	state.Difficulty = 3072
+	state.Sync.SyncMode = true
+	state.Sync.SyncProgressHeight = 100
+	state.Sync.SyncTipHeight = 200
+
+	status := fullNodeStatus("node-0", state, []chiarpc.Connection{{NodeID: "a"}, {NodeID: "b"}})
+	assert.Equal(t, k8schianetv1.ChiaNodeFullNodeStatus{
+		Pod:                "node-0",
+		PeakHeight:         0,
+		SyncMode:           true,
+		SyncProgressHeight: 100,
+		SyncTipHeight:      200,
+		Difficulty:         3072,
+		Connections:        2,
+	}, status)
+}
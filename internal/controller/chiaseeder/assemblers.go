@@ -10,7 +10,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -311,6 +313,44 @@ func assembleVolumeClaim(seeder k8schianetv1.ChiaSeeder) (*corev1.PersistentVolu
 	return &pvc, nil
 }
 
+// assemblePodDisruptionBudget assembles the PodDisruptionBudget resource for a ChiaSeeder CR
+func assemblePodDisruptionBudget(seeder k8schianetv1.ChiaSeeder) policyv1.PodDisruptionBudget {
+	inputs := kube.AssemblePodDisruptionBudgetInputs{
+		Name:           fmt.Sprintf(chiaseederNamePattern, seeder.Name),
+		Namespace:      seeder.Namespace,
+		Labels:         kube.GetCommonLabels(seeder.Kind, seeder.ObjectMeta, seeder.Spec.Labels),
+		Annotations:    seeder.Spec.Annotations,
+		SelectorLabels: kube.GetCommonLabels(seeder.Kind, seeder.ObjectMeta),
+	}
+
+	if seeder.Spec.PodDisruptionBudget != nil {
+		inputs.MinAvailable = seeder.Spec.PodDisruptionBudget.MinAvailable
+		inputs.MaxUnavailable = seeder.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+
+	return kube.AssemblePodDisruptionBudget(inputs)
+}
+
+// assembleHorizontalPodAutoscaler assembles the HorizontalPodAutoscaler resource for a ChiaSeeder CR
+func assembleHorizontalPodAutoscaler(seeder k8schianetv1.ChiaSeeder) autoscalingv2.HorizontalPodAutoscaler {
+	inputs := kube.AssembleHorizontalPodAutoscalerInputs{
+		Name:           fmt.Sprintf(chiaseederNamePattern, seeder.Name),
+		Namespace:      seeder.Namespace,
+		Labels:         kube.GetCommonLabels(seeder.Kind, seeder.ObjectMeta, seeder.Spec.Labels),
+		Annotations:    seeder.Spec.Annotations,
+		DeploymentName: fmt.Sprintf(chiaseederNamePattern, seeder.Name),
+	}
+
+	if seeder.Spec.Autoscaling != nil {
+		inputs.MinReplicas = seeder.Spec.Autoscaling.MinReplicas
+		inputs.MaxReplicas = seeder.Spec.Autoscaling.MaxReplicas
+		inputs.Metrics = seeder.Spec.Autoscaling.Metrics
+		inputs.Behavior = seeder.Spec.Autoscaling.Behavior
+	}
+
+	return kube.AssembleHorizontalPodAutoscaler(inputs)
+}
+
 // assembleDeployment assembles the seeder Deployment resource for a ChiaSeeder CR
 func assembleDeployment(seeder k8schianetv1.ChiaSeeder, fullNodePort int32, networkData *map[string]string) (appsv1.Deployment, error) {
 	var deploy = appsv1.Deployment{
@@ -350,7 +390,10 @@ func assembleDeployment(seeder k8schianetv1.ChiaSeeder, fullNodePort int32, netw
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(seeder.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(seeder.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(seeder.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range seeder.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -374,6 +417,11 @@ func assembleDeployment(seeder k8schianetv1.ChiaSeeder, fullNodePort int32, netw
 		deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, assembleChiaHealthcheckContainer(seeder))
 	}
 
+	// A HorizontalPodAutoscaler sets the replicas while autoscaling is enabled, so they're left to it
+	if !kube.ShouldMakeHorizontalPodAutoscaler(seeder.Spec.Autoscaling) {
+		deploy.Spec.Replicas = &seeder.Spec.Replicas
+	}
+
 	if seeder.Spec.Strategy != nil {
 		deploy.Spec.Strategy = *seeder.Spec.Strategy
 	}
@@ -382,7 +430,10 @@ func assembleDeployment(seeder k8schianetv1.ChiaSeeder, fullNodePort int32, netw
 		deploy.Spec.Template.Spec.SecurityContext = seeder.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, seeder.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -27,16 +27,23 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -48,21 +55,37 @@ type ChiaSeederReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiaseeders = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaSeeder resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the crawler RPC is queried for the ChiaSeeder status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	// PeerMetrics exports the peer counts in the ChiaSeeder status as operator metrics
+	PeerMetrics bool
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaseeders,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaseeders/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiaseeders/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaSeederReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaSeederKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -70,12 +93,7 @@ func (r *ChiaSeederReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	var seeder k8schianetv1.ChiaSeeder
 	err := r.Get(ctx, req.NamespacedName, &seeder)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiaseeders[req.String()]
-		if exists {
-			delete(chiaseeders, req.String())
-			metrics.ChiaSeeders.Sub(1.0)
-		}
+		metrics.DeleteCrawlerPeers(string(consts.ChiaSeederKind), req.Namespace, req.Name)
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -83,96 +101,89 @@ func (r *ChiaSeederReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiaseeders[req.String()]
-	if !exists {
-		chiaseeders[req.String()] = true
-		metrics.ChiaSeeders.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, seeder.Spec.ChiaConfig.CommonSpecChia, seeder.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &seeder, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Get the full_node Port and handle the error one time instead of in every function that needs it
 	fullNodePort, err := kube.GetFullNodePort(seeder.Spec.ChiaConfig.CommonSpecChia, networkData)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err)
+		return r.markFailed(ctx, &seeder, kube.ReasonChiaNetworkFailed, ctrl.Result{}, fmt.Errorf("encountered error retrieving the full_node Port to use: %v", err))
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(seeder, fullNodePort)
 	if err := controllerutil.SetControllerReference(&seeder, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(seeder, fullNodePort)
 	if err := controllerutil.SetControllerReference(&seeder, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(seeder)
 	if err := controllerutil.SetControllerReference(&seeder, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(seeder)
 	if err := controllerutil.SetControllerReference(&seeder, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(seeder)
 	if err := controllerutil.SetControllerReference(&seeder, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Healthcheck Service
 	healthcheckSrv := assembleChiaHealthcheckService(seeder)
 	if err := controllerutil.SetControllerReference(&seeder, &healthcheckSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder chia-healthcheck Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling chia-healthcheck Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling chia-healthcheck Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Healthcheck Service
 	if !kube.ShouldRollIntoMainPeerService(seeder.Spec.ChiaHealthcheckConfig.Service) {
 		res, err = kube.ReconcileService(ctx, r.Client, seeder.Spec.ChiaHealthcheckConfig.Service, healthcheckSrv, false)
 		if err != nil {
-			return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &seeder, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 		}
 	}
 
@@ -181,40 +192,106 @@ func (r *ChiaSeederReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		pvc, err := assembleVolumeClaim(seeder)
 		if err != nil {
 			r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &seeder, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, seeder.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to create seeder PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &seeder, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &seeder, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
+	// Assemble PodDisruptionBudget
+	pdb := assemblePodDisruptionBudget(seeder)
+	if err := controllerutil.SetControllerReference(&seeder, &pdb, r.Scheme); err != nil {
+		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &seeder, kube.ReasonPodDisruptionBudgetFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling PodDisruptionBudget: %v", req.NamespacedName, err))
+	}
+	// Reconcile PodDisruptionBudget
+	res, err = kube.ReconcilePodDisruptionBudget(ctx, r.Client, seeder.Spec.PodDisruptionBudget, pdb)
+	if err != nil {
+		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to create seeder PodDisruptionBudget -- Check operator logs.")
+		return r.markFailed(ctx, &seeder, kube.ReasonPodDisruptionBudgetFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+	}
+
+	// Assemble HorizontalPodAutoscaler
+	hpa := assembleHorizontalPodAutoscaler(seeder)
+	if err := controllerutil.SetControllerReference(&seeder, &hpa, r.Scheme); err != nil {
+		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &seeder, kube.ReasonHorizontalPodAutoscalerFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s encountered error assembling HorizontalPodAutoscaler: %v", req.NamespacedName, err))
+	}
+	// Reconcile HorizontalPodAutoscaler
+	res, err = kube.ReconcileHorizontalPodAutoscaler(ctx, r.Client, seeder.Spec.Autoscaling, hpa)
+	if err != nil {
+		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to create seeder HorizontalPodAutoscaler -- Check operator logs.")
+		return r.markFailed(ctx, &seeder, kube.ReasonHorizontalPodAutoscalerFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+	}
+
 	// Assemble Deployment
 	deploy, err := assembleDeployment(seeder, fullNodePort, networkData)
 	if err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&seeder, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to assemble seeder Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, seeder.Namespace, &deploy.Spec.Template, secretRefs(seeder), kube.ConfigMapRefs(seeder.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &seeder, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+	}
+	// Replicas that land on different nodes can't share a claim that only one node can mount at a time
+	if kube.GetMaxReplicas(seeder.Spec.Replicas, seeder.Spec.Autoscaling) > 1 {
+		if err := kube.CheckVolumeClaimsShareable(ctx, r.Client, seeder.Namespace, deploy.Spec.Template.Spec.Volumes); err != nil {
+			r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to scale seeder Deployment -- Check operator logs.")
+			return r.markFailed(ctx, &seeder, kube.ReasonPersistentVolumeClaimFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+		}
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &seeder.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&seeder, corev1.EventTypeWarning, "Failed", "Failed to create seeder Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &seeder, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
+	}
+	if seeder.Status.Recreation != nil {
+		return r.markRecreating(ctx, &seeder, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &seeder, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&seeder, corev1.EventTypeNormal, "Created", "Successfully created ChiaSeeder resources.")
-	seeder.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !seeder.Status.Ready {
+		r.Recorder.Event(&seeder, corev1.EventTypeNormal, "Created", "Successfully created ChiaSeeder resources.")
+	}
+	seeder.Status.Ready = rollout.Ready()
+	seeder.Status.ObservedGeneration = seeder.Generation
+	seeder.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&seeder.Status.Conditions, seeder.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		status, err := r.getPeerStatus(ctx, seeder, deploy.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaSeederReconciler ChiaSeeder=%s unable to query crawler RPC", req.NamespacedName))
+			metrics.DeleteCrawlerPeers(string(consts.ChiaSeederKind), seeder.Namespace, seeder.Name)
+		} else {
+			seeder.Status.Peers = status
+			if r.PeerMetrics && status != nil && status.Error == "" {
+				metrics.SetCrawlerPeers(string(consts.ChiaSeederKind), seeder.Namespace, seeder.Name, *status)
+			} else {
+				metrics.DeleteCrawlerPeers(string(consts.ChiaSeederKind), seeder.Namespace, seeder.Name)
+			}
+		}
+	}
 	err = r.Status().Update(ctx, &seeder)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -224,43 +301,133 @@ func (r *ChiaSeederReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaSeeder's status conditions, and returns the reconcile error
+func (r *ChiaSeederReconciler) markFailed(ctx context.Context, seeder *k8schianetv1.ChiaSeeder, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaSeederKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&seeder.Status.Conditions, seeder.Generation, reason, err.Error()) {
+		seeder.Status.ObservedGeneration = seeder.Generation
+		if updateErr := r.Status().Update(ctx, seeder); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaSeeder status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaSeeder's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaSeederReconciler) markRecreating(ctx context.Context, seeder *k8schianetv1.ChiaSeeder, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&seeder.Status.Conditions, seeder.Generation, "Deployment", *seeder.Status.Recreation)
+	seeder.Status.ObservedGeneration = seeder.Generation
+	if err := r.Status().Update(ctx, seeder); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaSeederReconciler ChiaSeeder=%s unable to update ChiaSeeder status: %v", client.ObjectKeyFromObject(seeder), err)
+	}
+	return res, nil
+}
+
+// getPeerStatus queries the crawler RPC of the ChiaSeeder's running pod for the peers it has found on the network.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaSeeder's CA Secret.
+// Returns nil if the ChiaSeeder has no CA Secret, since its certificates are then signed by a CA the operator doesn't have, or if it has no running pod.
+func (r *ChiaSeederReconciler) getPeerStatus(ctx context.Context, seeder k8schianetv1.ChiaSeeder, selector *metav1.LabelSelector) (*k8schianetv1.CrawlerPeerStatus, error) {
+	if seeder.Spec.ChiaConfig.CASecretName == nil || *seeder.Spec.ChiaConfig.CASecretName == "" {
+		return nil, nil
+	}
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, seeder.Namespace, *seeder.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, seeder.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	host := pods[0].Status.PodIP
+
+	counts, err := rpcClient.GetPeerCounts(ctx, host, consts.CrawlerRPCPort)
+	if err != nil {
+		return &k8schianetv1.CrawlerPeerStatus{Error: err.Error()}, nil
+	}
+	seenLastHour, err := rpcClient.CountIPsAfterTimestamp(ctx, host, consts.CrawlerRPCPort, time.Now().Add(-time.Hour))
+	if err != nil {
+		return &k8schianetv1.CrawlerPeerStatus{Error: err.Error()}, nil
+	}
+
+	return peerStatus(counts, seenLastHour), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaSeederReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaSeeders by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaSeeder{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaSeeder))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaSeeder{}).
+		For(&k8schianetv1.ChiaSeeder{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaSeeders that use the ChiaNetwork
 func (r *ChiaSeederReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaSeederList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaSeeders that reference it
+func (r *ChiaSeederReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaSeederList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
@@ -6,13 +6,16 @@ package chiaseeder
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	corev1 "k8s.io/api/core/v1"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/utils/ptr"
 )
 
 // getChiaVolumes retrieves the requisite volumes from the Chia config struct
@@ -45,6 +48,11 @@ func getChiaVolumes(seeder k8schianetv1.ChiaSeeder) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(seeder.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(seeder.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(seeder.Spec.ChiaConfig.CommonSpecChia, seeder.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
@@ -66,6 +74,11 @@ func getChiaVolumeMounts(seeder k8schianetv1.ChiaSeeder) []corev1.VolumeMount {
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(seeder.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	return v
 }
 
@@ -172,3 +185,29 @@ func getChiaPorts(fullNodePort int32) []corev1.ContainerPort {
 		},
 	}
 }
+
+// peerStatus converts a crawler's RPC responses to a CrawlerPeerStatus
+func peerStatus(counts chiarpc.PeerCounts, seenLastHour int) *k8schianetv1.CrawlerPeerStatus {
+	status := k8schianetv1.CrawlerPeerStatus{
+		Total:        int32(counts.TotalLast5Days),
+		Reliable:     int32(counts.ReliableNodes),
+		IPv4:         int32(counts.IPv4Last5Days),
+		IPv6:         int32(counts.IPv6Last5Days),
+		SeenLastHour: int32(seenLastHour),
+	}
+	for version, count := range counts.Versions {
+		status.Versions = append(status.Versions, k8schianetv1.CrawlerPeerVersion{
+			Version: version,
+			Count:   int32(count),
+		})
+	}
+	sort.Slice(status.Versions, func(i, j int) bool {
+		return status.Versions[i].Version < status.Versions[j].Version
+	})
+	return &status
+}
+
+// secretRefs returns the names of the Secrets the ChiaSeeder's pods reference, including its CA Secret
+func secretRefs(seeder k8schianetv1.ChiaSeeder) []string {
+	return kube.SecretRefs(seeder.Spec.ChiaConfig.CommonSpecChia, seeder.Spec.ChiaExporterConfig, ptr.Deref(seeder.Spec.ChiaConfig.CASecretName, ""))
+}
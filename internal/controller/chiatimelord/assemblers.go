@@ -327,7 +327,10 @@ func assembleDeployment(ctx context.Context, tl k8schianetv1.ChiaTimelord, netwo
 	deploy.Spec.Template.Spec.Containers = append(deploy.Spec.Template.Spec.Containers, chiaContainer)
 
 	// Get Init Containers
-	deploy.Spec.Template.Spec.InitContainers = kube.GetExtraContainers(tl.Spec.InitContainers, chiaContainer)
+	if kube.ShouldMountChiaCertificates(tl.Spec.ChiaConfig.CommonSpecChia) {
+		deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetChiaCertificatesInitContainer(chiaContainer))
+	}
+	deploy.Spec.Template.Spec.InitContainers = append(deploy.Spec.Template.Spec.InitContainers, kube.GetExtraContainers(tl.Spec.InitContainers, chiaContainer)...)
 	// Add Init Container Volumes
 	for _, init := range tl.Spec.InitContainers {
 		deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, init.Volumes...)
@@ -359,7 +362,10 @@ func assembleDeployment(ctx context.Context, tl k8schianetv1.ChiaTimelord, netwo
 		deploy.Spec.Template.Spec.SecurityContext = tl.Spec.PodSecurityContext
 	}
 
-	// TODO add pod tolerations
+	// The podTemplate is applied last, so it can override anything generated above
+	if err := kube.ApplyPodTemplate(&deploy.Spec.Template, tl.Spec.PodTemplate); err != nil {
+		return appsv1.Deployment{}, err
+	}
 
 	return deploy, nil
 }
@@ -385,7 +391,7 @@ func assembleChiaContainer(ctx context.Context, tl k8schianetv1.ChiaTimelord, ne
 				Protocol:      "TCP",
 			},
 		},
-		VolumeMounts: getChiaVolumeMounts(),
+		VolumeMounts: getChiaVolumeMounts(tl),
 	}
 
 	env, err := getChiaEnv(ctx, tl, networkData)
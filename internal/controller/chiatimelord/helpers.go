@@ -44,21 +44,36 @@ func getChiaVolumes(tl k8schianetv1.ChiaTimelord) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(tl.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(tl.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(tl.Spec.ChiaConfig.CommonSpecChia, &tl.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
 // getChiaVolumeMounts retrieves the requisite volume mounts from the Chia config struct
-func getChiaVolumeMounts() []corev1.VolumeMount {
-	return []corev1.VolumeMount{
-		{
-			Name:      "secret-ca",
-			MountPath: "/chia-ca",
-		},
-		{
-			Name:      "chiaroot",
-			MountPath: "/chia-data",
-		},
+func getChiaVolumeMounts(tl k8schianetv1.ChiaTimelord) []corev1.VolumeMount {
+	var v []corev1.VolumeMount
+
+	// secret ca volume
+	v = append(v, corev1.VolumeMount{
+		Name:      "secret-ca",
+		MountPath: "/chia-ca",
+	})
+
+	// CHIA_ROOT volume
+	v = append(v, corev1.VolumeMount{
+		Name:      "chiaroot",
+		MountPath: "/chia-data",
+	})
+
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(tl.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
 	}
+
+	return v
 }
 
 // getChiaEnv retrieves the environment variables from the Chia config struct
@@ -105,3 +120,8 @@ func getChiaEnv(ctx context.Context, timelord k8schianetv1.ChiaTimelord, network
 
 	return env, nil
 }
+
+// secretRefs returns the names of the Secrets the ChiaTimelord's pods reference, including its CA Secret
+func secretRefs(tl k8schianetv1.ChiaTimelord) []string {
+	return kube.SecretRefs(tl.Spec.ChiaConfig.CommonSpecChia, tl.Spec.ChiaExporterConfig, tl.Spec.ChiaConfig.CASecretName)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func TestGetChiaVolumeMounts(t *testing.T) {
-	volumeMounts := getChiaVolumeMounts()
+	volumeMounts := getChiaVolumeMounts(k8schianetv1.ChiaTimelord{})
 
 	assert.Len(t, volumeMounts, 2, "Expected 2 volume mounts")
 	expectedVolumeMounts := []struct {
@@ -11,16 +11,21 @@ import (
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"github.com/chia-network/chia-operator/internal/metrics"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,21 +37,32 @@ type ChiaWalletReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-}
 
-var chiawallets = make(map[string]bool)
+	// MaxConcurrentReconciles is the number of ChiaWallet resources that can be reconciled at once. Defaults to 1 if zero.
+	MaxConcurrentReconciles int
+
+	// RPCStatusInterval is how often the wallet RPC is queried for the ChiaWallet status. Querying is disabled if zero.
+	RPCStatusInterval time.Duration
+
+	rpcClients chiarpc.ClientCache
+}
 
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiawallets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiawallets/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=k8s.chia.net,resources=chiawallets/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is invoked on any event to a controlled Kubernetes resource
 func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer metrics.ObserveReconcileDuration(string(consts.ChiaWalletKind), time.Now())
+
 	log := log.FromContext(ctx)
 	log.Info("Running reconciler...")
 
@@ -54,12 +70,6 @@ func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	var wallet k8schianetv1.ChiaWallet
 	err := r.Get(ctx, req.NamespacedName, &wallet)
 	if err != nil && errors.IsNotFound(err) {
-		// Remove this object from the map for tracking and subtract this CR's total metric by 1
-		_, exists := chiawallets[req.String()]
-		if exists {
-			delete(chiawallets, req.String())
-			metrics.ChiaWallets.Sub(1.0)
-		}
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
@@ -67,77 +77,70 @@ func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Add this object to the tracking map and increment the gauge by 1, if it wasn't already added
-	_, exists := chiawallets[req.String()]
-	if !exists {
-		chiawallets[req.String()] = true
-		metrics.ChiaWallets.Add(1.0)
-	}
-
 	// Check for ChiaNetwork, retrieve matching ConfigMap if specified
 	networkData, err := kube.GetChiaNetworkData(ctx, r.Client, wallet.Spec.ChiaConfig.CommonSpecChia, wallet.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.markFailed(ctx, &wallet, kube.ReasonChiaNetworkFailed, ctrl.Result{}, err)
 	}
 
 	// Assemble Peer Service
 	peerSrv := assemblePeerService(wallet)
 	if err := controllerutil.SetControllerReference(&wallet, &peerSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet peer Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling peer Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling peer Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Peer Service
 	res, err := kube.ReconcileService(ctx, r.Client, wallet.Spec.ChiaConfig.PeerService, peerSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble All Service
 	allSrv := assembleAllService(wallet)
 	if err := controllerutil.SetControllerReference(&wallet, &allSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet all-port Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling all-port Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling all-port Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile All Service
 	res, err = kube.ReconcileService(ctx, r.Client, wallet.Spec.ChiaConfig.AllService, allSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Daemon Service
 	daemonSrv := assembleDaemonService(wallet)
 	if err := controllerutil.SetControllerReference(&wallet, &daemonSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet daemon Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling daemon Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling daemon Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Daemon Service
 	res, err = kube.ReconcileService(ctx, r.Client, wallet.Spec.ChiaConfig.DaemonService, daemonSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble RPC Service
 	rpcSrv := assembleRPCService(wallet)
 	if err := controllerutil.SetControllerReference(&wallet, &rpcSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet RPC Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling RPC Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling RPC Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile RPC Service
 	res, err = kube.ReconcileService(ctx, r.Client, wallet.Spec.ChiaConfig.RPCService, rpcSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Assemble Chia-Exporter Service
 	exporterSrv := assembleChiaExporterService(wallet)
 	if err := controllerutil.SetControllerReference(&wallet, &exporterSrv, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet chia-exporter Service -- Check operator logs.")
-		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s encountered error assembling chia-exporter Service: %v", req.NamespacedName, err))
 	}
 	// Reconcile Chia-Exporter Service
 	res, err = kube.ReconcileService(ctx, r.Client, wallet.Spec.ChiaExporterConfig.Service, exporterSrv, true)
 	if err != nil {
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonServiceFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Creates a persistent volume claim if the GenerateVolumeClaims setting was set to true
@@ -145,17 +148,17 @@ func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		pvc, err := assembleVolumeClaim(wallet)
 		if err != nil {
 			r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet PVC -- Check operator logs.")
-			return reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+			return r.markFailed(ctx, &wallet, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 		}
 
 		if pvc != nil {
 			res, err = kube.ReconcilePersistentVolumeClaim(ctx, r.Client, wallet.Spec.Storage, *pvc)
 			if err != nil {
 				r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to create wallet PVC -- Check operator logs.")
-				return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+				return r.markFailed(ctx, &wallet, kube.ReasonPersistentVolumeClaimFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 			}
 		} else {
-			return reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s PVC could not be created", req.NamespacedName)
+			return r.markFailed(ctx, &wallet, kube.ReasonPersistentVolumeClaimFailed, reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s PVC could not be created", req.NamespacedName))
 		}
 	}
 
@@ -163,22 +166,49 @@ func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	deploy, err := assembleDeployment(ctx, wallet, networkData)
 	if err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 	if err := controllerutil.SetControllerReference(&wallet, &deploy, r.Scheme); err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to assemble wallet Deployment -- Check operator logs.")
-		return reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonDeploymentFailed, reconcile.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
+	}
+	// Stamp checksums of the Secrets and ConfigMaps the pods reference, so a change to any of them rolls out the pods
+	if err := kube.SetChecksumAnnotations(ctx, r.Client, wallet.Namespace, &deploy.Spec.Template, secretRefs(wallet), kube.ConfigMapRefs(wallet.Spec.ChiaConfig.CommonSpecChia)); err != nil {
+		return r.markFailed(ctx, &wallet, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 	// Reconcile Deployment
-	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy)
+	res, err = kube.ReconcileDeployment(ctx, r.Client, deploy, &wallet.Status.WorkloadStatus)
 	if err != nil {
 		r.Recorder.Event(&wallet, corev1.EventTypeWarning, "Failed", "Failed to create wallet Deployment -- Check operator logs.")
-		return res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err)
+		return r.markFailed(ctx, &wallet, kube.ReasonDeploymentFailed, res, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
+	}
+	if wallet.Status.Recreation != nil {
+		return r.markRecreating(ctx, &wallet, res)
+	}
+
+	// Get the Deployment's rollout, which the CR status is derived from
+	rollout, err := kube.GetDeploymentRollout(ctx, r.Client, types.NamespacedName{Namespace: deploy.Namespace, Name: deploy.Name})
+	if err != nil {
+		return r.markFailed(ctx, &wallet, kube.ReasonDeploymentFailed, ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s %v", req.NamespacedName, err))
 	}
 
 	// Update CR status
-	r.Recorder.Event(&wallet, corev1.EventTypeNormal, "Created", "Successfully created ChiaWallet resources.")
-	wallet.Status.Ready = true
+	// Only report the resources as created when they first become ready, since this runs on every RPC status poll
+	if rollout.Ready() && !wallet.Status.Ready {
+		r.Recorder.Event(&wallet, corev1.EventTypeNormal, "Created", "Successfully created ChiaWallet resources.")
+	}
+	wallet.Status.Ready = rollout.Ready()
+	wallet.Status.ObservedGeneration = wallet.Generation
+	wallet.Status.WorkloadStatus = rollout.Status
+	kube.SetRolloutConditions(&wallet.Status.Conditions, wallet.Generation, rollout)
+	if r.RPCStatusInterval > 0 {
+		status, err := r.getWalletStatus(ctx, wallet, deploy.Spec.Selector)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("ChiaWalletReconciler ChiaWallet=%s unable to query wallet RPC", req.NamespacedName))
+		} else {
+			wallet.Status.Wallet = status
+		}
+	}
 	err = r.Status().Update(ctx, &wallet)
 	if err != nil {
 		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
@@ -188,43 +218,147 @@ func (r *ChiaWalletReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: r.RPCStatusInterval}, nil
+}
+
+// markFailed records a subresource that couldn't be reconciled in the ChiaWallet's status conditions, and returns the reconcile error
+func (r *ChiaWalletReconciler) markFailed(ctx context.Context, wallet *k8schianetv1.ChiaWallet, reason string, res ctrl.Result, err error) (ctrl.Result, error) {
+	metrics.RecordReconcileError(string(consts.ChiaWalletKind), kube.ReconcileStep(reason))
+	if kube.SetFailedConditions(&wallet.Status.Conditions, wallet.Generation, reason, err.Error()) {
+		wallet.Status.ObservedGeneration = wallet.Generation
+		if updateErr := r.Status().Update(ctx, wallet); updateErr != nil && !strings.Contains(updateErr.Error(), kube.ObjectModifiedTryAgainError) {
+			log.FromContext(ctx).Error(updateErr, "unable to update ChiaWallet status")
+		}
+	}
+	return res, err
+}
+
+// markRecreating records the recreation of the ChiaWallet's Deployment in its status, and requeues the reconcile to carry on with it
+func (r *ChiaWalletReconciler) markRecreating(ctx context.Context, wallet *k8schianetv1.ChiaWallet, res ctrl.Result) (ctrl.Result, error) {
+	kube.SetRecreatingConditions(&wallet.Status.Conditions, wallet.Generation, "Deployment", *wallet.Status.Recreation)
+	wallet.Status.ObservedGeneration = wallet.Generation
+	if err := r.Status().Update(ctx, wallet); err != nil {
+		if strings.Contains(err.Error(), kube.ObjectModifiedTryAgainError) {
+			return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("ChiaWalletReconciler ChiaWallet=%s unable to update ChiaWallet status: %v", client.ObjectKeyFromObject(wallet), err)
+	}
+	return res, nil
+}
+
+// getWalletStatus queries the wallet RPC of the ChiaWallet's running pod for its sync state, and its balance if enabled.
+// The RPC client authenticates with a certificate signed by the private CA in the ChiaWallet's CA Secret.
+// Returns nil if the ChiaWallet has no CA Secret, since its certificates are then signed by a CA the operator doesn't have, or if it has no running pod.
+func (r *ChiaWalletReconciler) getWalletStatus(ctx context.Context, wallet k8schianetv1.ChiaWallet, selector *metav1.LabelSelector) (*k8schianetv1.ChiaWalletSyncStatus, error) {
+	if wallet.Spec.ChiaConfig.CASecretName == nil || *wallet.Spec.ChiaConfig.CASecretName == "" {
+		return nil, nil
+	}
+	rpcClient, err := r.rpcClients.ForCASecretName(ctx, r.Client, wallet.Namespace, *wallet.Spec.ChiaConfig.CASecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := kube.ListWorkloadPods(ctx, r.Client, wallet.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	pods = kube.RunningPods(pods)
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	host := pods[0].Status.PodIP
+
+	syncStatus, err := rpcClient.GetSyncStatus(ctx, host, consts.WalletRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaWalletSyncStatus{Error: err.Error()}, nil
+	}
+	height, err := rpcClient.GetHeightInfo(ctx, host, consts.WalletRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaWalletSyncStatus{Error: err.Error()}, nil
+	}
+	fingerprint, err := rpcClient.GetLoggedInFingerprint(ctx, host, consts.WalletRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaWalletSyncStatus{Error: err.Error()}, nil
+	}
+	connections, err := rpcClient.GetConnections(ctx, host, consts.WalletRPCPort)
+	if err != nil {
+		return &k8schianetv1.ChiaWalletSyncStatus{Error: err.Error()}, nil
+	}
+	var balance *chiarpc.WalletBalance
+	if wallet.Spec.ReportBalance != nil && *wallet.Spec.ReportBalance {
+		standard, err := rpcClient.GetWalletBalance(ctx, host, consts.WalletRPCPort, chiarpc.StandardWalletID)
+		if err != nil {
+			return &k8schianetv1.ChiaWalletSyncStatus{Error: err.Error()}, nil
+		}
+		balance = &standard
+	}
+
+	return walletSyncStatus(syncStatus, height, fingerprint, connections, balance), nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChiaWalletReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index ChiaWallets by the Secrets they reference, so a change to a Secret can be mapped back to them
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &k8schianetv1.ChiaWallet{}, kube.SecretRefsIndexField, func(obj client.Object) []string {
+		return secretRefs(*obj.(*k8schianetv1.ChiaWallet))
+	})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&k8schianetv1.ChiaWallet{}).
+		For(&k8schianetv1.ChiaWallet{}, builder.WithPredicates(kube.IgnoreStatusUpdates)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.handleChiaNetworks),
+			builder.WithPredicates(kube.OwnedByChiaNetwork),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.handleSecrets),
 		).
 		Complete(r)
 }
 
+// handleChiaNetworks maps a change to a ChiaNetwork's ConfigMap to the ChiaWallets that use the ChiaNetwork
 func (r *ChiaWalletReconciler) handleChiaNetworks(ctx context.Context, obj client.Object) []reconcile.Request {
-	listOps := &client.ListOptions{
-		Namespace: obj.GetNamespace(),
+	list := &k8schianetv1.ChiaWalletList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.ChiaNetworkIndexField: obj.GetName()})
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
+	return requests
+}
+
+// handleSecrets maps a change to a Secret to the ChiaWallets that reference it
+func (r *ChiaWalletReconciler) handleSecrets(ctx context.Context, obj client.Object) []reconcile.Request {
 	list := &k8schianetv1.ChiaWalletList{}
-	err := r.List(ctx, list, listOps)
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{kube.SecretRefsIndexField: obj.GetName()})
 	if err != nil {
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(list.Items))
-	for i, item := range list.Items {
-		chiaNetwork := item.Spec.ChiaConfig.ChiaNetwork
-		if chiaNetwork != nil && *chiaNetwork == obj.GetName() {
-			requests[i] = reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      item.GetName(),
-					Namespace: item.GetNamespace(),
-				},
-			}
-		}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
 	}
 	return requests
 }
@@ -8,13 +8,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/kube"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 // getChiaPorts returns the ports to a chia container
@@ -78,6 +82,11 @@ func getChiaVolumes(wallet k8schianetv1.ChiaWallet) []corev1.Volume {
 		v = append(v, kube.GetExistingChiaRootVolume(wallet.Spec.Storage))
 	}
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(wallet.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolume(wallet.Spec.ChiaConfig.CommonSpecChia, wallet.Spec.ChiaConfig.CASecretName))
+	}
+
 	return v
 }
 
@@ -104,6 +113,11 @@ func getChiaVolumeMounts(wallet k8schianetv1.ChiaWallet) []corev1.VolumeMount {
 		MountPath: "/chia-data",
 	})
 
+	// pre-generated certificates volume
+	if kube.ShouldMountChiaCertificates(wallet.Spec.ChiaConfig.CommonSpecChia) {
+		v = append(v, kube.GetChiaCertificatesVolumeMount())
+	}
+
 	return v
 }
 
@@ -120,7 +134,7 @@ func getChiaEnv(ctx context.Context, wallet k8schianetv1.ChiaWallet, networkData
 
 	// trusted_cidrs env var
 	if wallet.Spec.ChiaConfig.TrustedCIDRs != nil {
-		// TODO should any special CIDR input checking happen here
+		// CIDRs are checked by the validating webhook, when it's enabled
 		cidrs, err := json.Marshal(*wallet.Spec.ChiaConfig.TrustedCIDRs)
 		if err != nil {
 			logr.Error(err, fmt.Sprintf("ChiaWalletReconciler ChiaWallet=%s given CIDRs could not be marshalled to json. Peer connections that you would expect to be trusted might not be trusted.", wallet.Name))
@@ -178,3 +192,33 @@ func getChiaEnv(ctx context.Context, wallet k8schianetv1.ChiaWallet, networkData
 
 	return env, nil
 }
+
+// walletSyncStatus converts a wallet's RPC responses to a ChiaWalletSyncStatus. balance is nil if balance reporting is disabled.
+func walletSyncStatus(syncStatus chiarpc.SyncStatus, height uint32, fingerprint *uint32, connections []chiarpc.Connection, balance *chiarpc.WalletBalance) *k8schianetv1.ChiaWalletSyncStatus {
+	status := k8schianetv1.ChiaWalletSyncStatus{
+		Synced:  syncStatus.Synced,
+		Syncing: syncStatus.Syncing,
+		Height:  int64(height),
+	}
+	if fingerprint != nil {
+		status.Fingerprint = int64(*fingerprint)
+	}
+	for _, conn := range connections {
+		if conn.Type != chiarpc.NodeTypeFullNode {
+			continue
+		}
+		status.FullNodePeers = append(status.FullNodePeers, net.JoinHostPort(conn.PeerHost, strconv.Itoa(conn.PeerPort)))
+	}
+	if balance != nil {
+		confirmed := int64(balance.ConfirmedWalletBalance)
+		spendable := int64(balance.SpendableBalance)
+		status.ConfirmedBalance = &confirmed
+		status.SpendableBalance = &spendable
+	}
+	return &status
+}
+
+// secretRefs returns the names of the Secrets the ChiaWallet's pods reference, including its CA and mnemonic Secrets
+func secretRefs(wallet k8schianetv1.ChiaWallet) []string {
+	return kube.SecretRefs(wallet.Spec.ChiaConfig.CommonSpecChia, wallet.Spec.ChiaExporterConfig, ptr.Deref(wallet.Spec.ChiaConfig.CASecretName, ""), wallet.Spec.ChiaConfig.SecretKey.Name)
+}
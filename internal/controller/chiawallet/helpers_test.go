@@ -3,6 +3,7 @@ package chiawallet
 import (
 	"testing"
 
+	"github.com/chia-network/chia-operator/internal/controller/common/chiarpc"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -324,7 +325,50 @@ func TestGetChiaVolumes(t *testing.T) {
 	}
 }
 
+func TestWalletSyncStatus(t *testing.T) {
+	fingerprint := uint32(1234567890)
+	connections := []chiarpc.Connection{
+		{NodeID: "a", PeerHost: "10.0.0.1", PeerPort: 8444, Type: chiarpc.NodeTypeFullNode},
+		{NodeID: "b", PeerHost: "10.0.0.2", PeerPort: 8444, Type: 3},
+	}
+
+	status := walletSyncStatus(chiarpc.SyncStatus{Synced: true}, 6543210, &fingerprint, connections, nil)
+	assert.Equal(t, &k8schianetv1.ChiaWalletSyncStatus{
+		Synced:        true,
+		Height:        6543210,
+		Fingerprint:   1234567890,
+		FullNodePeers: []string{"10.0.0.1:8444"},
+	}, status)
+
+	// Balance reporting enabled, before a key is logged in
+	balance := chiarpc.WalletBalance{WalletID: chiarpc.StandardWalletID, ConfirmedWalletBalance: 2000000000000, SpendableBalance: 1000000000000}
+	status = walletSyncStatus(chiarpc.SyncStatus{Syncing: true}, 100, nil, nil, &balance)
+	assert.True(t, status.Syncing)
+	assert.Equal(t, int64(0), status.Fingerprint)
+	assert.Empty(t, status.FullNodePeers)
+	assert.Equal(t, int64(2000000000000), *status.ConfirmedBalance)
+	assert.Equal(t, int64(1000000000000), *status.SpendableBalance)
+}
+
 // Helper function to create a string pointer
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestSecretRefs(t *testing.T) {
+	wallet := k8schianetv1.ChiaWallet{
+		Spec: k8schianetv1.ChiaWalletSpec{
+			ChiaConfig: k8schianetv1.ChiaWalletSpecChia{
+				SecretKey: k8schianetv1.ChiaSecretKey{
+					Name: "mnemonic",
+					Key:  "key.txt",
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"mnemonic"}, secretRefs(wallet))
+
+	caSecretName := "ca"
+	wallet.Spec.ChiaConfig.CASecretName = &caSecretName
+	assert.Equal(t, []string{"ca", "mnemonic"}, secretRefs(wallet))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+// Package chiarpc queries the RPC servers of the Chia services the operator runs, to report their state in resource statuses.
+package chiarpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	chiatls "github.com/chia-network/go-chia-libs/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// privateCACrtKey is the key in a CA Secret that contains the private CA certificate
+	privateCACrtKey = "private_ca.crt"
+
+	// privateCAKeyKey is the key in a CA Secret that contains the private CA key
+	privateCAKeyKey = "private_ca.key"
+
+	// chiaServerName is the DNS name in the certificates Chia services serve their RPC with
+	chiaServerName = "chia.net"
+
+	// requestTimeout bounds each RPC request, so an unresponsive pod doesn't hold up reconciliation
+	requestTimeout = 5 * time.Second
+)
+
+// Client makes requests to the RPC servers of Chia services.
+// Chia RPC servers require mutual TLS, so the Client authenticates with a certificate signed by the private CA the services trust.
+type Client struct {
+	httpClient *http.Client
+}
+
+// Response contains the fields every Chia RPC response has
+type Response struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NewClient returns a Client with a new client certificate signed by the given private CA.
+// The private CA certificate may be a bundle, in which case the first certificate signs the client certificate, and all of them are trusted.
+func NewClient(caCrt, caKey []byte) (*Client, error) {
+	block, _ := pem.Decode(caCrt)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("failed to decode private CA certificate PEM")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private CA certificate: %v", err)
+	}
+	key, err := chiatls.ParsePemKey(caKey)
+	if err != nil {
+		return nil, err
+	}
+	if !chiatls.CertMatchesPrivateKey(cert, key) {
+		return nil, fmt.Errorf("private CA certificate does not match its key")
+	}
+
+	clientCrt, clientKey, err := chiatls.GenerateCASignedCert(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate RPC client certificate: %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCrt) {
+		return nil, fmt.Errorf("failed to add private CA certificate to the trusted roots")
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates: []tls.Certificate{
+						{
+							Certificate: [][]byte{clientCrt},
+							PrivateKey:  clientKey,
+						},
+					},
+					RootCAs:    roots,
+					ServerName: chiaServerName,
+					MinVersion: tls.VersionTLS12,
+				},
+			},
+		},
+	}, nil
+}
+
+// Do sends a request to an RPC endpoint of the Chia service at host:port, and decodes the response.
+// Returns an error if the request fails, or the service responds that it wasn't successful.
+func (c *Client) Do(ctx context.Context, host string, port int, endpoint string, request any, response any) error {
+	if request == nil {
+		request = struct{}{}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %v", endpoint, err)
+	}
+
+	url := fmt.Sprintf("https://%s/%s", net.JoinHostPort(host, strconv.Itoa(port)), endpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %v", endpoint, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %v", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %v", endpoint, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s request returned HTTP %d", endpoint, resp.StatusCode)
+	}
+
+	var result Response
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("failed to decode %s response: %v", endpoint, err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%s request was unsuccessful: %s", endpoint, result.Error)
+	}
+	if response != nil {
+		if err := json.Unmarshal(respBody, response); err != nil {
+			return fmt.Errorf("failed to decode %s response: %v", endpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// ClientCache keeps a Client for each CA Secret, since generating a client certificate is too slow to do on every reconciliation.
+// A Client is replaced when its CA Secret changes, and evicted when its CA Secret is found to be deleted. The zero value is ready to use.
+type ClientCache struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]cachedClient
+}
+
+type cachedClient struct {
+	uid             types.UID
+	resourceVersion string
+	client          *Client
+}
+
+// ForSecret returns the Client for a CA Secret, creating it if the Secret is new or has changed
+func (c *ClientCache) ForSecret(secret corev1.Secret) (*Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+	if cached, ok := c.clients[key]; ok && cached.uid == secret.UID && cached.resourceVersion == secret.ResourceVersion {
+		return cached.client, nil
+	}
+
+	rpcClient, err := NewClient(secret.Data[privateCACrtKey], secret.Data[privateCAKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("CA Secret %s: %v", secret.Name, err)
+	}
+	if c.clients == nil {
+		c.clients = make(map[types.NamespacedName]cachedClient)
+	}
+	c.clients[key] = cachedClient{
+		uid:             secret.UID,
+		resourceVersion: secret.ResourceVersion,
+		client:          rpcClient,
+	}
+
+	return rpcClient, nil
+}
+
+// ForCASecretName gets a CA Secret, and returns its Client.
+// The cached Client for a CA Secret that no longer exists is evicted, so the cache doesn't grow with every Secret it has seen.
+func (c *ClientCache) ForCASecretName(ctx context.Context, k8sClient client.Client, namespace, name string) (*Client, error) {
+	var secret corev1.Secret
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		if errors.IsNotFound(err) {
+			c.evict(types.NamespacedName{Namespace: namespace, Name: name})
+		}
+		return nil, fmt.Errorf("error getting CA Secret %s: %v", name, err)
+	}
+	return c.ForSecret(secret)
+}
+
+// evict removes the cached Client for a CA Secret
+func (c *ClientCache) evict(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.clients, key)
+}
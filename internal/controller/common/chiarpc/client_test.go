@@ -0,0 +1,225 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	chiatls "github.com/chia-network/go-chia-libs/pkg/tls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// generateCA returns a new private CA certificate and key PEM
+func generateCA(t *testing.T) ([]byte, []byte) {
+	caDER, caKey, err := chiatls.GenerateNewCA()
+	require.NoError(t, err)
+	caCrt, caKeyPEM, err := chiatls.EncodeCertAndKeyToPEM(caDER, caKey)
+	require.NoError(t, err)
+	return caCrt, caKeyPEM
+}
+
+// newStubServer starts a stand-in Chia RPC server that requires a client certificate signed by the private CA, like a Chia service does.
+// Each endpoint responds with its value in responses, marshalled to JSON. Returns the server's host and port.
+func newStubServer(t *testing.T, caCrt, caKey []byte, responses map[string]any) (string, int) {
+	caCert, err := chiatls.ParsePemCertificate(caCrt)
+	require.NoError(t, err)
+	key, err := chiatls.ParsePemKey(caKey)
+	require.NoError(t, err)
+	serverCrt, serverKey, err := chiatls.GenerateCASignedCert(caCert, key)
+	require.NoError(t, err)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCrt}, PrivateKey: serverKey}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum
+}
+
+func TestClient_Do(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"healthz": map[string]any{"success": true},
+		"failing": map[string]any{"success": false, "error": "not ready"},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Do(context.Background(), host, port, "healthz", nil, nil))
+
+	err = client.Do(context.Background(), host, port, "failing", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not ready")
+
+	err = client.Do(context.Background(), host, port, "missing", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP 404")
+}
+
+func TestClient_Do_UntrustedCA(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"healthz": map[string]any{"success": true},
+	})
+
+	otherCrt, otherKey := generateCA(t)
+	client, err := NewClient(otherCrt, otherKey)
+	require.NoError(t, err)
+
+	require.Error(t, client.Do(context.Background(), host, port, "healthz", nil, nil))
+}
+
+func TestNewClient_Invalid(t *testing.T) {
+	caCrt, _ := generateCA(t)
+	_, otherKey := generateCA(t)
+
+	_, err := NewClient(nil, nil)
+	require.Error(t, err)
+
+	_, err = NewClient(caCrt, otherKey)
+	require.Error(t, err)
+}
+
+func TestClientCache_ForSecret(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", UID: "uid", ResourceVersion: "1"},
+		Data: map[string][]byte{
+			"private_ca.crt": caCrt,
+			"private_ca.key": caKey,
+		},
+	}
+
+	var cache ClientCache
+	client, err := cache.ForSecret(secret)
+	require.NoError(t, err)
+
+	cached, err := cache.ForSecret(secret)
+	require.NoError(t, err)
+	assert.Same(t, client, cached)
+
+	secret.ResourceVersion = "2"
+	replaced, err := cache.ForSecret(secret)
+	require.NoError(t, err)
+	assert.NotSame(t, client, replaced)
+
+	_, err = cache.ForSecret(corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty", UID: "other"}})
+	require.Error(t, err)
+}
+
+func TestClientCache_ForCASecretName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	caCrt, caKey := generateCA(t)
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+		Data: map[string][]byte{
+			"private_ca.crt": caCrt,
+			"private_ca.key": caKey,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&secret).Build()
+	ctx := context.Background()
+
+	var cache ClientCache
+	client, err := cache.ForCASecretName(ctx, c, "default", "ca")
+	require.NoError(t, err)
+	cached, err := cache.ForCASecretName(ctx, c, "default", "ca")
+	require.NoError(t, err)
+	assert.Same(t, client, cached)
+	assert.Len(t, cache.clients, 1)
+
+	// The Client is evicted once its Secret is deleted
+	require.NoError(t, c.Delete(ctx, &secret))
+	_, err = cache.ForCASecretName(ctx, c, "default", "ca")
+	require.Error(t, err)
+	assert.Empty(t, cache.clients)
+
+	// A Secret recreated with the same name gets a new Client
+	secret.ResourceVersion = ""
+	secret.UID = "recreated"
+	require.NoError(t, c.Create(ctx, &secret))
+	recreated, err := cache.ForCASecretName(ctx, c, "default", "ca")
+	require.NoError(t, err)
+	assert.NotSame(t, client, recreated)
+}
+
+func TestClient_FullNode(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_blockchain_state": map[string]any{
+			"success": true,
+			"blockchain_state": map[string]any{
+				"difficulty": 3072,
+				"peak":       map[string]any{"height": 5000000},
+				"sync": map[string]any{
+					"sync_mode":            true,
+					"sync_progress_height": 4000000,
+					"sync_tip_height":      5100000,
+					"synced":               false,
+				},
+			},
+		},
+		"get_connections": map[string]any{
+			"success": true,
+			"connections": []map[string]any{
+				{"node_id": "a", "peer_host": "10.0.0.1", "type": 1},
+				{"node_id": "b", "peer_host": "10.0.0.2", "type": 1},
+			},
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	state, err := client.GetBlockchainState(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3072), state.Difficulty)
+	assert.Equal(t, uint32(5000000), state.PeakHeight())
+	assert.True(t, state.Sync.SyncMode)
+	assert.False(t, state.Sync.Synced)
+	assert.Equal(t, uint32(4000000), state.Sync.SyncProgressHeight)
+	assert.Equal(t, uint32(5100000), state.Sync.SyncTipHeight)
+
+	connections, err := client.GetConnections(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Len(t, connections, 2)
+
+	assert.Equal(t, uint32(0), BlockchainState{}.PeakHeight())
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"time"
+)
+
+// PeerCounts is the part of a crawler's get_peer_counts response the operator reports
+type PeerCounts struct {
+	TotalLast5Days int            `json:"total_last_5_days"`
+	ReliableNodes  int            `json:"reliable_nodes"`
+	IPv4Last5Days  int            `json:"ipv4_last_5_days"`
+	IPv6Last5Days  int            `json:"ipv6_last_5_days"`
+	Versions       map[string]int `json:"versions"`
+}
+
+// GetPeerCounts calls the crawler's get_peer_counts RPC endpoint
+func (c *Client) GetPeerCounts(ctx context.Context, host string, port int) (PeerCounts, error) {
+	var resp struct {
+		PeerCounts PeerCounts `json:"peer_counts"`
+	}
+	if err := c.Do(ctx, host, port, "get_peer_counts", nil, &resp); err != nil {
+		return PeerCounts{}, err
+	}
+	return resp.PeerCounts, nil
+}
+
+// CountIPsAfterTimestamp calls the crawler's get_ips_after_timestamp RPC endpoint, and returns the number of peers it has seen since after.
+// Only the count is requested, since the crawler can know of tens of thousands of peers.
+func (c *Client) CountIPsAfterTimestamp(ctx context.Context, host string, port int, after time.Time) (int, error) {
+	request := struct {
+		After  int64 `json:"after"`
+		Offset int   `json:"offset"`
+		Limit  int   `json:"limit"`
+	}{
+		After: after.Unix(),
+		Limit: 1,
+	}
+	var resp struct {
+		Total int `json:"total"`
+	}
+	if err := c.Do(ctx, host, port, "get_ips_after_timestamp", request, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Total, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Crawler(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_peer_counts": map[string]any{
+			"success": true,
+			"peer_counts": map[string]any{
+				"total_last_5_days": 30000,
+				"reliable_nodes":    8000,
+				"ipv4_last_5_days":  25000,
+				"ipv6_last_5_days":  5000,
+				"versions": map[string]any{
+					"2.5.0": 20000,
+					"2.4.4": 10000,
+				},
+			},
+		},
+		"get_ips_after_timestamp": map[string]any{
+			"success": true,
+			"ips":     []string{"10.0.0.1"},
+			"total":   12000,
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	counts, err := client.GetPeerCounts(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Equal(t, PeerCounts{
+		TotalLast5Days: 30000,
+		ReliableNodes:  8000,
+		IPv4Last5Days:  25000,
+		IPv6Last5Days:  5000,
+		Versions:       map[string]int{"2.5.0": 20000, "2.4.4": 10000},
+	}, counts)
+
+	seen, err := client.CountIPsAfterTimestamp(context.Background(), host, port, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 12000, seen)
+}
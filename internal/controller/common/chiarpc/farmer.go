@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// HarvesterSummary is a harvester connected to a farmer, from the farmer's get_harvesters_summary response
+type HarvesterSummary struct {
+	Connection struct {
+		NodeID string `json:"node_id"`
+		Host   string `json:"host"`
+		Port   int    `json:"port"`
+	} `json:"connection"`
+	Plots                  int     `json:"plots"`
+	FailedToOpenFilenames  int     `json:"failed_to_open_filenames"`
+	NoKeyFilenames         int     `json:"no_key_filenames"`
+	Duplicates             int     `json:"duplicates"`
+	TotalPlotSize          uint64  `json:"total_plot_size"`
+	TotalEffectivePlotSize uint64  `json:"total_effective_plot_size"`
+	LastSyncTime           float64 `json:"last_sync_time"`
+}
+
+// PoolState is the farmer's state with one of its pools, from its get_pool_state response
+type PoolState struct {
+	PoolConfig struct {
+		LauncherID string `json:"launcher_id"`
+		PoolURL    string `json:"pool_url"`
+	} `json:"pool_config"`
+	CurrentPoints         uint64            `json:"current_points"`
+	CurrentDifficulty     *uint64           `json:"current_difficulty"`
+	PointsFound24h        [][2]float64      `json:"points_found_24h"`
+	PointsAcknowledged24h [][2]float64      `json:"points_acknowledged_24h"`
+	PoolErrors24h         []json.RawMessage `json:"pool_errors_24h"`
+	PlotCount             int               `json:"plot_count"`
+}
+
+// LastPointFound returns when the farmer last found a proof for the pool in the past 24 hours, or the zero time if it hasn't
+func (s PoolState) LastPointFound() time.Time {
+	var last float64
+	for _, point := range s.PointsFound24h {
+		if point[0] > last {
+			last = point[0]
+		}
+	}
+	if last == 0 {
+		return time.Time{}
+	}
+	return UnixTime(last)
+}
+
+// GetHarvestersSummary calls the farmer's get_harvesters_summary RPC endpoint
+func (c *Client) GetHarvestersSummary(ctx context.Context, host string, port int) ([]HarvesterSummary, error) {
+	var resp struct {
+		Harvesters []HarvesterSummary `json:"harvesters"`
+	}
+	if err := c.Do(ctx, host, port, "get_harvesters_summary", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Harvesters, nil
+}
+
+// GetPoolState calls the farmer's get_pool_state RPC endpoint
+func (c *Client) GetPoolState(ctx context.Context, host string, port int) ([]PoolState, error) {
+	var resp struct {
+		PoolState []PoolState `json:"pool_state"`
+	}
+	if err := c.Do(ctx, host, port, "get_pool_state", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PoolState, nil
+}
+
+// UnixTime converts the float seconds Chia RPC responses use for timestamps to a time.Time
+func UnixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+}
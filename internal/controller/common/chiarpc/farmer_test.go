@@ -0,0 +1,71 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Farmer(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_harvesters_summary": map[string]any{
+			"success": true,
+			"harvesters": []map[string]any{
+				{
+					"connection":                map[string]any{"node_id": "abc", "host": "10.0.0.5", "port": 8448},
+					"plots":                     100,
+					"failed_to_open_filenames":  1,
+					"no_key_filenames":          2,
+					"duplicates":                3,
+					"total_plot_size":           10885000000000,
+					"total_effective_plot_size": 10890000000000,
+					"last_sync_time":            1700000000.5,
+				},
+			},
+		},
+		"get_pool_state": map[string]any{
+			"success": true,
+			"pool_state": []map[string]any{
+				{
+					"pool_config":             map[string]any{"launcher_id": "0xlauncher", "pool_url": "https://pool.example.com"},
+					"current_points":          500,
+					"current_difficulty":      10,
+					"points_found_24h":        [][]float64{{1700000000, 10}, {1700000600, 10}},
+					"points_acknowledged_24h": [][]float64{{1700000000, 10}},
+					"pool_errors_24h":         []map[string]any{{"error_code": 5, "error_message": "too late"}},
+					"plot_count":              100,
+				},
+			},
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	harvesters, err := client.GetHarvestersSummary(context.Background(), host, port)
+	require.NoError(t, err)
+	require.Len(t, harvesters, 1)
+	assert.Equal(t, "10.0.0.5", harvesters[0].Connection.Host)
+	assert.Equal(t, 100, harvesters[0].Plots)
+	assert.Equal(t, 3, harvesters[0].Duplicates)
+	assert.Equal(t, uint64(10890000000000), harvesters[0].TotalEffectivePlotSize)
+
+	pools, err := client.GetPoolState(context.Background(), host, port)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "https://pool.example.com", pools[0].PoolConfig.PoolURL)
+	require.NotNil(t, pools[0].CurrentDifficulty)
+	assert.Equal(t, uint64(10), *pools[0].CurrentDifficulty)
+	assert.Len(t, pools[0].PoolErrors24h, 1)
+	assert.Equal(t, time.Unix(1700000600, 0).UTC(), pools[0].LastPointFound())
+
+	assert.True(t, PoolState{}.LastPointFound().IsZero())
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+)
+
+// BlockchainState is the part of a full_node's get_blockchain_state response the operator reports
+type BlockchainState struct {
+	Difficulty uint64 `json:"difficulty"`
+	Peak       *struct {
+		Height uint32 `json:"height"`
+	} `json:"peak"`
+	Sync struct {
+		SyncMode           bool   `json:"sync_mode"`
+		SyncProgressHeight uint32 `json:"sync_progress_height"`
+		SyncTipHeight      uint32 `json:"sync_tip_height"`
+		Synced             bool   `json:"synced"`
+	} `json:"sync"`
+}
+
+// PeakHeight returns the height of the full_node's peak, or 0 if it doesn't have a peak yet
+func (s BlockchainState) PeakHeight() uint32 {
+	if s.Peak == nil {
+		return 0
+	}
+	return s.Peak.Height
+}
+
+// NodeTypeFullNode is the connection type of a full_node peer
+const NodeTypeFullNode = 1
+
+// Connection is a peer connection of a Chia service
+type Connection struct {
+	NodeID   string `json:"node_id"`
+	PeerHost string `json:"peer_host"`
+	PeerPort int    `json:"peer_port"`
+	Type     int    `json:"type"`
+}
+
+// GetBlockchainState calls the full_node's get_blockchain_state RPC endpoint
+func (c *Client) GetBlockchainState(ctx context.Context, host string, port int) (BlockchainState, error) {
+	var resp struct {
+		BlockchainState BlockchainState `json:"blockchain_state"`
+	}
+	if err := c.Do(ctx, host, port, "get_blockchain_state", nil, &resp); err != nil {
+		return BlockchainState{}, err
+	}
+	return resp.BlockchainState, nil
+}
+
+// GetConnections calls the get_connections RPC endpoint of a Chia service
+func (c *Client) GetConnections(ctx context.Context, host string, port int) ([]Connection, error) {
+	var resp struct {
+		Connections []Connection `json:"connections"`
+	}
+	if err := c.Do(ctx, host, port, "get_connections", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Connections, nil
+}
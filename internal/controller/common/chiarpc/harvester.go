@@ -0,0 +1,74 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// uiActualSpaceConstantFactor is the factor chia multiplies a plot's expected size by to display its effective space
+const uiActualSpaceConstantFactor = 0.78
+
+// Plot is a plot loaded by a harvester, from its get_plots response
+type Plot struct {
+	Filename string `json:"filename"`
+	Size     uint8  `json:"size"`
+	FileSize uint64 `json:"file_size"`
+}
+
+// Plots is a harvester's get_plots response
+type Plots struct {
+	Plots                 []Plot   `json:"plots"`
+	FailedToOpenFilenames []string `json:"failed_to_open_filenames"`
+	NotFoundFilenames     []string `json:"not_found_filenames"`
+}
+
+// RawSpace returns the total size of the plot files on disk
+func (p Plots) RawSpace() uint64 {
+	var total uint64
+	for _, plot := range p.Plots {
+		total += plot.FileSize
+	}
+	return total
+}
+
+// EffectiveSpace returns the space the plots are worth in the farming lottery, the same way chia displays it.
+// Compressed plots are worth the space of an uncompressed plot of their k size.
+func (p Plots) EffectiveSpace() uint64 {
+	var total uint64
+	for _, plot := range p.Plots {
+		total += uint64(uiActualSpaceConstantFactor * float64(ExpectedPlotSize(plot.Size)))
+	}
+	return total
+}
+
+// ExpectedPlotSize returns the expected size in bytes of an uncompressed plot with the given k size
+func ExpectedPlotSize(k uint8) uint64 {
+	if k == 0 {
+		return 0
+	}
+	return (2*uint64(k) + 1) << (k - 1)
+}
+
+// GetPlots calls the harvester's get_plots RPC endpoint
+func (c *Client) GetPlots(ctx context.Context, host string, port int) (Plots, error) {
+	var resp Plots
+	if err := c.Do(ctx, host, port, "get_plots", nil, &resp); err != nil {
+		return Plots{}, err
+	}
+	return resp, nil
+}
+
+// SpaceQuantity returns a number of bytes as a Quantity rounded to 3 significant figures, so it's readable in a resource status
+func SpaceQuantity(bytes uint64) *resource.Quantity {
+	scale := uint64(1)
+	for bytes/scale >= 1000 {
+		scale *= 10
+	}
+	rounded := (bytes + scale/2) / scale * scale
+	return resource.NewQuantity(int64(rounded), resource.DecimalSI)
+}
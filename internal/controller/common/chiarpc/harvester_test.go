@@ -0,0 +1,52 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetPlots(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_plots": map[string]any{
+			"success": true,
+			"plots": []map[string]any{
+				{"filename": "/plots/plot-k32-1.plot", "size": 32, "file_size": 108000000000},
+				{"filename": "/plots/plot-k32-2.plot", "size": 32, "file_size": 85000000000},
+			},
+			"failed_to_open_filenames": []string{"/plots/broken.plot"},
+			"not_found_filenames":      []string{},
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	plots, err := client.GetPlots(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Len(t, plots.Plots, 2)
+	assert.Equal(t, []string{"/plots/broken.plot"}, plots.FailedToOpenFilenames)
+	assert.Equal(t, uint64(193000000000), plots.RawSpace())
+	// Both plots are k32, so the second compressed plot is worth as much as the first
+	assert.Equal(t, 2*uint64(0.78*float64(ExpectedPlotSize(32))), plots.EffectiveSpace())
+}
+
+func TestExpectedPlotSize(t *testing.T) {
+	assert.Equal(t, uint64(0), ExpectedPlotSize(0))
+	assert.Equal(t, uint64(65*(1<<31)), ExpectedPlotSize(32))
+	assert.Equal(t, uint64(51*(1<<24)), ExpectedPlotSize(25))
+}
+
+func TestSpaceQuantity(t *testing.T) {
+	assert.Equal(t, "0", SpaceQuantity(0).String())
+	assert.Equal(t, "999", SpaceQuantity(999).String())
+	assert.Equal(t, "109G", SpaceQuantity(108886235136).String())
+	assert.Equal(t, "1010T", SpaceQuantity(1005000000000000).String())
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+)
+
+// SyncStatus is a wallet's get_sync_status response
+type SyncStatus struct {
+	Synced             bool `json:"synced"`
+	Syncing            bool `json:"syncing"`
+	GenesisInitialized bool `json:"genesis_initialized"`
+}
+
+// WalletBalance is the balance of one of a wallet's wallets, from its get_wallet_balance response. Amounts are in mojos.
+type WalletBalance struct {
+	WalletID                 int    `json:"wallet_id"`
+	ConfirmedWalletBalance   uint64 `json:"confirmed_wallet_balance"`
+	UnconfirmedWalletBalance uint64 `json:"unconfirmed_wallet_balance"`
+	SpendableBalance         uint64 `json:"spendable_balance"`
+}
+
+// StandardWalletID is the ID of a wallet's standard XCH wallet
+const StandardWalletID = 1
+
+// GetSyncStatus calls the wallet's get_sync_status RPC endpoint
+func (c *Client) GetSyncStatus(ctx context.Context, host string, port int) (SyncStatus, error) {
+	var resp SyncStatus
+	if err := c.Do(ctx, host, port, "get_sync_status", nil, &resp); err != nil {
+		return SyncStatus{}, err
+	}
+	return resp, nil
+}
+
+// GetHeightInfo calls the wallet's get_height_info RPC endpoint, and returns the height the wallet has synced to
+func (c *Client) GetHeightInfo(ctx context.Context, host string, port int) (uint32, error) {
+	var resp struct {
+		Height uint32 `json:"height"`
+	}
+	if err := c.Do(ctx, host, port, "get_height_info", nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Height, nil
+}
+
+// GetLoggedInFingerprint calls the wallet's get_logged_in_fingerprint RPC endpoint.
+// Returns nil if no key is logged in.
+func (c *Client) GetLoggedInFingerprint(ctx context.Context, host string, port int) (*uint32, error) {
+	var resp struct {
+		Fingerprint *uint32 `json:"fingerprint"`
+	}
+	if err := c.Do(ctx, host, port, "get_logged_in_fingerprint", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Fingerprint, nil
+}
+
+// GetWalletBalance calls the wallet's get_wallet_balance RPC endpoint for one of its wallets
+func (c *Client) GetWalletBalance(ctx context.Context, host string, port int, walletID int) (WalletBalance, error) {
+	request := struct {
+		WalletID int `json:"wallet_id"`
+	}{
+		WalletID: walletID,
+	}
+	var resp struct {
+		WalletBalance WalletBalance `json:"wallet_balance"`
+	}
+	if err := c.Do(ctx, host, port, "get_wallet_balance", request, &resp); err != nil {
+		return WalletBalance{}, err
+	}
+	return resp.WalletBalance, nil
+}
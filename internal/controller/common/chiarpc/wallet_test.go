@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package chiarpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Wallet(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_sync_status": map[string]any{
+			"success":             true,
+			"synced":              true,
+			"syncing":             false,
+			"genesis_initialized": true,
+		},
+		"get_height_info": map[string]any{
+			"success": true,
+			"height":  6543210,
+		},
+		"get_logged_in_fingerprint": map[string]any{
+			"success":     true,
+			"fingerprint": 1234567890,
+		},
+		"get_wallet_balance": map[string]any{
+			"success": true,
+			"wallet_balance": map[string]any{
+				"wallet_id":                  1,
+				"confirmed_wallet_balance":   2000000000000,
+				"unconfirmed_wallet_balance": 2000000000000,
+				"spendable_balance":          1000000000000,
+			},
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	syncStatus, err := client.GetSyncStatus(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.True(t, syncStatus.Synced)
+	assert.False(t, syncStatus.Syncing)
+
+	height, err := client.GetHeightInfo(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6543210), height)
+
+	fingerprint, err := client.GetLoggedInFingerprint(context.Background(), host, port)
+	require.NoError(t, err)
+	require.NotNil(t, fingerprint)
+	assert.Equal(t, uint32(1234567890), *fingerprint)
+
+	balance, err := client.GetWalletBalance(context.Background(), host, port, StandardWalletID)
+	require.NoError(t, err)
+	assert.Equal(t, StandardWalletID, balance.WalletID)
+	assert.Equal(t, uint64(2000000000000), balance.ConfirmedWalletBalance)
+	assert.Equal(t, uint64(1000000000000), balance.SpendableBalance)
+}
+
+func TestClient_Wallet_NotLoggedIn(t *testing.T) {
+	caCrt, caKey := generateCA(t)
+	host, port := newStubServer(t, caCrt, caKey, map[string]any{
+		"get_logged_in_fingerprint": map[string]any{
+			"success":     true,
+			"fingerprint": nil,
+		},
+	})
+
+	client, err := NewClient(caCrt, caKey)
+	require.NoError(t, err)
+
+	fingerprint, err := client.GetLoggedInFingerprint(context.Background(), host, port)
+	require.NoError(t, err)
+	assert.Nil(t, fingerprint)
+}
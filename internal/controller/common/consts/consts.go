@@ -11,9 +11,15 @@ const (
 	// ChiaCAKind is the API Kind for Chia certificate authorities
 	ChiaCAKind ChiaKind = "ChiaCA"
 
+	// ChiaCertificatesKind is the API Kind for Chia certificates
+	ChiaCertificatesKind ChiaKind = "ChiaCertificates"
+
 	// ChiaCrawlerKind is the API Kind for Chia crawlers
 	ChiaCrawlerKind ChiaKind = "ChiaCrawler"
 
+	// ChiaDataLayerKind is the API Kind for Chia data_layers
+	ChiaDataLayerKind ChiaKind = "ChiaDataLayer"
+
 	// ChiaFarmerKind is the API Kind for Chia farmers
 	ChiaFarmerKind ChiaKind = "ChiaFarmer"
 
@@ -23,6 +29,12 @@ const (
 	// ChiaIntroducerKind is the API Kind for Chia introducers
 	ChiaIntroducerKind ChiaKind = "ChiaIntroducer"
 
+	// ChiaKeyKind is the API Kind for Chia mnemonic keys
+	ChiaKeyKind ChiaKind = "ChiaKey"
+
+	// ChiaNetworkKind is the API Kind for Chia network configurations
+	ChiaNetworkKind ChiaKind = "ChiaNetwork"
+
 	// ChiaNodeKind is the API Kind for Chia full_nodes
 	ChiaNodeKind ChiaKind = "ChiaNode"
 
@@ -3,7 +3,9 @@ package kube
 import (
 	"fmt"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -82,7 +84,7 @@ type AssembleChiaContainerInputs struct {
 // AssembleChiaContainer assembles a chia container spec
 func AssembleChiaContainer(input AssembleChiaContainerInputs) corev1.Container {
 	container := corev1.Container{
-		Name:            "chia",
+		Name:            ChiaContainerName,
 		ImagePullPolicy: input.ImagePullPolicy,
 		Env:             input.Env,
 		Ports:           input.Ports,
@@ -272,3 +274,77 @@ func AssembleChiaHealthcheckProbe(input AssembleChiaHealthcheckProbeInputs) *cor
 
 	return &probe
 }
+
+// AssemblePodDisruptionBudgetInputs contains configuration inputs to the AssemblePodDisruptionBudget function
+type AssemblePodDisruptionBudgetInputs struct {
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	Annotations    map[string]string
+	SelectorLabels map[string]string
+	MinAvailable   *intstr.IntOrString
+	MaxUnavailable *intstr.IntOrString
+}
+
+// AssemblePodDisruptionBudget accepts some values and outputs a kubernetes PodDisruptionBudget definition in a standard way.
+// If neither minAvailable nor maxUnavailable is given, one pod may be disrupted at a time.
+func AssemblePodDisruptionBudget(input AssemblePodDisruptionBudgetInputs) policyv1.PodDisruptionBudget {
+	pdb := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        input.Name,
+			Namespace:   input.Namespace,
+			Labels:      input.Labels,
+			Annotations: input.Annotations,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: input.SelectorLabels,
+			},
+			MinAvailable:   input.MinAvailable,
+			MaxUnavailable: input.MaxUnavailable,
+		},
+	}
+
+	if input.MinAvailable == nil && input.MaxUnavailable == nil {
+		maxUnavailable := intstr.FromInt32(1)
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+	}
+
+	return pdb
+}
+
+// AssembleHorizontalPodAutoscalerInputs contains configuration inputs to the AssembleHorizontalPodAutoscaler function
+type AssembleHorizontalPodAutoscalerInputs struct {
+	Name           string
+	Namespace      string
+	Labels         map[string]string
+	Annotations    map[string]string
+	DeploymentName string
+	MinReplicas    *int32
+	MaxReplicas    int32
+	Metrics        []autoscalingv2.MetricSpec
+	Behavior       *autoscalingv2.HorizontalPodAutoscalerBehavior
+}
+
+// AssembleHorizontalPodAutoscaler accepts some values and outputs a kubernetes HorizontalPodAutoscaler definition that scales a Deployment in a standard way
+func AssembleHorizontalPodAutoscaler(input AssembleHorizontalPodAutoscalerInputs) autoscalingv2.HorizontalPodAutoscaler {
+	return autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        input.Name,
+			Namespace:   input.Namespace,
+			Labels:      input.Labels,
+			Annotations: input.Annotations,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       input.DeploymentName,
+			},
+			MinReplicas: input.MinReplicas,
+			MaxReplicas: input.MaxReplicas,
+			Metrics:     input.Metrics,
+			Behavior:    input.Behavior,
+		},
+	}
+}
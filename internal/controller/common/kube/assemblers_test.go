@@ -11,7 +11,9 @@ import (
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 
 	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 )
 
 var testControllerOwner = true
@@ -522,3 +524,113 @@ func TestAssembleChiaHealthcheckProbe_Full(t *testing.T) {
 	})
 	require.Equal(t, expected, *actual)
 }
+
+func TestAssemblePodDisruptionBudget_Minimal(t *testing.T) {
+	maxUnavailable := intstr.FromInt32(1)
+	expected := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-node",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/name": "test",
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/name": "test",
+				},
+			},
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+	actual := AssemblePodDisruptionBudget(AssemblePodDisruptionBudgetInputs{
+		Name:           expected.Name,
+		Namespace:      expected.Namespace,
+		Labels:         expected.Labels,
+		SelectorLabels: expected.Spec.Selector.MatchLabels,
+	})
+	require.Equal(t, expected, actual)
+}
+
+func TestAssemblePodDisruptionBudget_Full(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	expected := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-node",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/name": "test",
+				"extra-label":            "value",
+			},
+			Annotations: map[string]string{
+				"test-annotation": "testing",
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/name": "test",
+				},
+			},
+			MinAvailable: &minAvailable,
+		},
+	}
+	actual := AssemblePodDisruptionBudget(AssemblePodDisruptionBudgetInputs{
+		Name:           expected.Name,
+		Namespace:      expected.Namespace,
+		Labels:         expected.Labels,
+		Annotations:    expected.Annotations,
+		SelectorLabels: expected.Spec.Selector.MatchLabels,
+		MinAvailable:   &minAvailable,
+	})
+	require.Equal(t, expected, actual)
+}
+
+func TestAssembleHorizontalPodAutoscaler(t *testing.T) {
+	metrics := []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.To(int32(80)),
+				},
+			},
+		},
+	}
+	expected := autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-seeder",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/name": "test",
+			},
+			Annotations: map[string]string{
+				"test-annotation": "testing",
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "test-seeder",
+			},
+			MinReplicas: ptr.To(int32(2)),
+			MaxReplicas: 5,
+			Metrics:     metrics,
+		},
+	}
+	actual := AssembleHorizontalPodAutoscaler(AssembleHorizontalPodAutoscalerInputs{
+		Name:           expected.Name,
+		Namespace:      expected.Namespace,
+		Labels:         expected.Labels,
+		Annotations:    expected.Annotations,
+		DeploymentName: "test-seeder",
+		MinReplicas:    ptr.To(int32(2)),
+		MaxReplicas:    5,
+		Metrics:        metrics,
+	})
+	require.Equal(t, expected, actual)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// cert-manager objects are handled as unstructured objects so the operator doesn't depend on cert-manager's API packages,
+// and doesn't require cert-manager's CRDs to be installed unless the CertManager certificate backend is used.
+var (
+	// CertManagerIssuerGVK is the GroupVersionKind of cert-manager Issuers
+	CertManagerIssuerGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+
+	// CertManagerCertificateGVK is the GroupVersionKind of cert-manager Certificates
+	CertManagerCertificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+)
+
+const (
+	// chiaCertificateOrganization is the subject organization Chia uses in its certificates
+	chiaCertificateOrganization = "Chia"
+
+	// chiaCertificateOrganizationalUnit is the subject organizational unit Chia uses in its certificates
+	chiaCertificateOrganizationalUnit = "Organic Farming Division"
+)
+
+// AssembleCertManagerSelfSignedIssuer assembles a cert-manager Issuer that self-signs certificates
+func AssembleCertManagerSelfSignedIssuer(name, namespace string, labels map[string]string) unstructured.Unstructured {
+	return assembleCertManagerObject(CertManagerIssuerGVK, name, namespace, labels, map[string]interface{}{
+		"selfSigned": map[string]interface{}{},
+	})
+}
+
+// AssembleCertManagerCAIssuer assembles a cert-manager Issuer that signs certificates with the CA in a kubernetes.io/tls Secret
+func AssembleCertManagerCAIssuer(name, namespace string, labels map[string]string, secretName string) unstructured.Unstructured {
+	return assembleCertManagerObject(CertManagerIssuerGVK, name, namespace, labels, map[string]interface{}{
+		"ca": map[string]interface{}{
+			"secretName": secretName,
+		},
+	})
+}
+
+// AssembleCertManagerCACertificate assembles a cert-manager Certificate for a Chia private CA, with the same subject and key usages go-chia-libs gives a generated CA
+func AssembleCertManagerCACertificate(name, namespace string, labels map[string]string, secretName string, issuerRef k8schianetv1.CertManagerIssuerRef) unstructured.Unstructured {
+	return assembleCertManagerObject(CertManagerCertificateGVK, name, namespace, labels, map[string]interface{}{
+		"secretName": secretName,
+		"isCA":       true,
+		"commonName": "Chia CA",
+		"subject":    chiaCertificateSubject(),
+		"duration":   "87600h",
+		"privateKey": chiaCertificatePrivateKey(),
+		"usages": []interface{}{
+			"cert sign",
+			"digital signature",
+		},
+		"issuerRef": certManagerIssuerRef(issuerRef),
+	})
+}
+
+// AssembleCertManagerChiaCertificate assembles a cert-manager Certificate for a Chia service certificate-key pair,
+// with the same subject and DNS name go-chia-libs gives a generated certificate. Chia services use these certificates for mutual TLS.
+func AssembleCertManagerChiaCertificate(name, namespace string, labels map[string]string, secretName string, issuerRef k8schianetv1.CertManagerIssuerRef, renewBefore *time.Duration) unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"secretName": secretName,
+		"commonName": "Chia",
+		"subject":    chiaCertificateSubject(),
+		"dnsNames": []interface{}{
+			"chia.net",
+		},
+		"privateKey": chiaCertificatePrivateKey(),
+		"usages": []interface{}{
+			"digital signature",
+			"key encipherment",
+			"server auth",
+			"client auth",
+		},
+		"issuerRef": certManagerIssuerRef(issuerRef),
+	}
+	if renewBefore != nil {
+		spec["renewBefore"] = renewBefore.String()
+	}
+	return assembleCertManagerObject(CertManagerCertificateGVK, name, namespace, labels, spec)
+}
+
+// CertManagerObjectName converts a name with underscores, like a Chia certificate name, to a valid Kubernetes object name
+func CertManagerObjectName(parts ...string) string {
+	return strings.ReplaceAll(strings.Join(parts, "-"), "_", "-")
+}
+
+// ReconcileCertManagerObject uses the controller-runtime client to determine if an unstructured cert-manager object needs to be created or its spec updated
+func ReconcileCertManagerObject(ctx context.Context, c client.Client, desired unstructured.Unstructured) (reconcile.Result, error) {
+	kind := desired.GetKind()
+	klog := log.FromContext(ctx).WithValues(kind+".Namespace", desired.GetNamespace(), kind+".Name", desired.GetName())
+
+	current := unstructured.Unstructured{}
+	current.SetGroupVersionKind(desired.GroupVersionKind())
+	err := c.Get(ctx, types.NamespacedName{
+		Name:      desired.GetName(),
+		Namespace: desired.GetNamespace(),
+	}, &current)
+	if err != nil && errors.IsNotFound(err) {
+		klog.Info("Creating new " + kind)
+		if err := c.Create(ctx, &desired); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error creating %s \"%s\": %v", kind, desired.GetName(), err)
+		}
+	} else if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting existing %s \"%s\": %v", kind, desired.GetName(), err)
+	} else if !reflect.DeepEqual(current.Object["spec"], desired.Object["spec"]) || !reflect.DeepEqual(current.GetLabels(), desired.GetLabels()) {
+		current.Object["spec"] = desired.Object["spec"]
+		current.SetLabels(desired.GetLabels())
+		if err := c.Update(ctx, &current); err != nil {
+			if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
+				return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("error updating %s \"%s\": %v", kind, desired.GetName(), err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func assembleCertManagerObject(gvk schema.GroupVersionKind, name, namespace string, labels map[string]string, spec map[string]interface{}) unstructured.Unstructured {
+	obj := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func chiaCertificateSubject() map[string]interface{} {
+	return map[string]interface{}{
+		"organizations": []interface{}{
+			chiaCertificateOrganization,
+		},
+		"organizationalUnits": []interface{}{
+			chiaCertificateOrganizationalUnit,
+		},
+	}
+}
+
+// chiaCertificatePrivateKey returns the cert-manager private key settings for Chia, which expects 2048 bit RSA keys in PKCS#8 format
+func chiaCertificatePrivateKey() map[string]interface{} {
+	return map[string]interface{}{
+		"algorithm":      "RSA",
+		"size":           int64(2048),
+		"encoding":       "PKCS8",
+		"rotationPolicy": "Always",
+	}
+}
+
+func certManagerIssuerRef(ref k8schianetv1.CertManagerIssuerRef) map[string]interface{} {
+	kind := ref.Kind
+	if kind == "" {
+		kind = CertManagerIssuerGVK.Kind
+	}
+	group := ref.Group
+	if group == "" {
+		group = CertManagerIssuerGVK.Group
+	}
+	return map[string]interface{}{
+		"name":  ref.Name,
+		"kind":  kind,
+		"group": group,
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"testing"
+	"time"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAssembleCertManagerCAIssuer(t *testing.T) {
+	issuer := AssembleCertManagerCAIssuer("issuer", "testnamespace", map[string]string{"app": "test"}, "ca-secret")
+
+	assert.Equal(t, CertManagerIssuerGVK, issuer.GroupVersionKind())
+	assert.Equal(t, "issuer", issuer.GetName())
+	assert.Equal(t, "testnamespace", issuer.GetNamespace())
+	assert.Equal(t, map[string]string{"app": "test"}, issuer.GetLabels())
+	secretName, _, err := unstructured.NestedString(issuer.Object, "spec", "ca", "secretName")
+	require.NoError(t, err)
+	assert.Equal(t, "ca-secret", secretName)
+}
+
+func TestAssembleCertManagerCACertificate(t *testing.T) {
+	cert := AssembleCertManagerCACertificate("ca", "testnamespace", nil, "ca-secret", k8schianetv1.CertManagerIssuerRef{Name: "selfsigned"})
+
+	assert.Equal(t, CertManagerCertificateGVK, cert.GroupVersionKind())
+	isCA, _, err := unstructured.NestedBool(cert.Object, "spec", "isCA")
+	require.NoError(t, err)
+	assert.True(t, isCA)
+	issuerRef, _, err := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "selfsigned", "kind": "Issuer", "group": "cert-manager.io"}, issuerRef)
+	encoding, _, err := unstructured.NestedString(cert.Object, "spec", "privateKey", "encoding")
+	require.NoError(t, err)
+	assert.Equal(t, "PKCS8", encoding)
+}
+
+func TestAssembleCertManagerChiaCertificate(t *testing.T) {
+	ref := k8schianetv1.CertManagerIssuerRef{Name: "vault", Kind: "ClusterIssuer", Group: "example.com"}
+	cert := AssembleCertManagerChiaCertificate("cert", "testnamespace", nil, "cert-secret", ref, nil)
+
+	secretName, _, err := unstructured.NestedString(cert.Object, "spec", "secretName")
+	require.NoError(t, err)
+	assert.Equal(t, "cert-secret", secretName)
+	dnsNames, _, err := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chia.net"}, dnsNames)
+	issuerRef, _, err := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "vault", "kind": "ClusterIssuer", "group": "example.com"}, issuerRef)
+	_, found, err := unstructured.NestedString(cert.Object, "spec", "renewBefore")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	renewBefore := 48 * time.Hour
+	cert = AssembleCertManagerChiaCertificate("cert", "testnamespace", nil, "cert-secret", ref, &renewBefore)
+	value, _, err := unstructured.NestedString(cert.Object, "spec", "renewBefore")
+	require.NoError(t, err)
+	assert.Equal(t, "48h0m0s", value)
+}
+
+func TestCertManagerObjectName(t *testing.T) {
+	assert.Equal(t, "certs-private-full-node", CertManagerObjectName("certs", "private_full_node"))
+}
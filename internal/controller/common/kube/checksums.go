@@ -0,0 +1,128 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ChecksumAnnotationPrefix prefixes the pod template annotations that hold a checksum of each Secret and ConfigMap a Chia resource references.
+	// A change to a referenced object's data changes its checksum, which rolls the workload's pods so they pick up the change.
+	ChecksumAnnotationPrefix = "checksum.k8s.chia.net/"
+
+	// SecretRefsIndexField is the field index of the Secrets a Chia resource references, which a change to a Secret is mapped back to its resources with
+	SecretRefsIndexField = ".spec.secretRefs"
+)
+
+// SecretRefs returns the names of the Secrets a Chia resource's pods reference: its pre-generated certificates Secret, its chia-exporter config Secret,
+// and the others given, such as its CA and mnemonic Secrets. Empty names are left out.
+func SecretRefs(commonSpecChia k8schianetv1.CommonSpecChia, exporter k8schianetv1.SpecChiaExporter, names ...string) []string {
+	if ShouldMountChiaCertificates(commonSpecChia) {
+		names = append(names, *commonSpecChia.CertificatesSecretName)
+	}
+	if exporter.ConfigSecretName != nil {
+		names = append(names, *exporter.ConfigSecretName)
+	}
+
+	var refs []string
+	for _, name := range names {
+		if name != "" && !slices.Contains(refs, name) {
+			refs = append(refs, name)
+		}
+	}
+	return refs
+}
+
+// ConfigMapRefs returns the names of the ConfigMaps a Chia resource's pods are configured from, which is its ChiaNetwork's ConfigMap if it has one
+func ConfigMapRefs(commonSpecChia k8schianetv1.CommonSpecChia) []string {
+	if commonSpecChia.ChiaNetwork != nil && *commonSpecChia.ChiaNetwork != "" {
+		return []string{*commonSpecChia.ChiaNetwork}
+	}
+	return nil
+}
+
+// SetChecksumAnnotations stamps a checksum of the data of each of the named Secrets and ConfigMaps on a pod template.
+// Objects that don't exist are skipped, since the pods that reference them report that on their own.
+func SetChecksumAnnotations(ctx context.Context, c client.Client, namespace string, template *corev1.PodTemplateSpec, secrets, configMaps []string) error {
+	checksums := make(map[string]string)
+
+	for _, name := range secrets {
+		var secret corev1.Secret
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting Secret \"%s\" to checksum: %v", name, err)
+		}
+		checksums[checksumAnnotation("secret", name)] = checksumData(secret.Data)
+	}
+
+	for _, name := range configMaps {
+		var configMap corev1.ConfigMap
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &configMap)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting ConfigMap \"%s\" to checksum: %v", name, err)
+		}
+		data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for key, value := range configMap.Data {
+			data[key] = []byte(value)
+		}
+		for key, value := range configMap.BinaryData {
+			data[key] = value
+		}
+		checksums[checksumAnnotation("configmap", name)] = checksumData(data)
+	}
+
+	// The pod template's annotations may be shared with the resource's spec, so they're copied rather than added to
+	template.Annotations = CombineMaps(template.Annotations, checksums)
+	return nil
+}
+
+// checksumAnnotation returns the checksum annotation key of a referenced object.
+// Names too long for an annotation key are truncated, and suffixed with a hash of the full name to keep them unique.
+func checksumAnnotation(kind, name string) string {
+	key := kind + "-" + name
+	if len(key) > validation.LabelValueMaxLength {
+		sum := sha256.Sum256([]byte(name))
+		key = strings.TrimRight(key[:validation.LabelValueMaxLength-9], "-.") + "-" + hex.EncodeToString(sum[:])[:8]
+	}
+	return ChecksumAnnotationPrefix + key
+}
+
+// checksumData returns a SHA-256 checksum of a Secret's or ConfigMap's data, which doesn't depend on the order of its keys
+func checksumData(data map[string][]byte) string {
+	var keys []string
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(data[key])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
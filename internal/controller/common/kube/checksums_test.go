@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretRefs(t *testing.T) {
+	commonSpecChia := k8schianetv1.CommonSpecChia{CertificatesSecretName: ptr.To("certs")}
+	exporter := k8schianetv1.SpecChiaExporter{ConfigSecretName: ptr.To("exporter")}
+
+	assert.Equal(t, []string{"ca", "mnemonic", "certs", "exporter"}, SecretRefs(commonSpecChia, exporter, "ca", "mnemonic"))
+	assert.Equal(t, []string{"ca"}, SecretRefs(k8schianetv1.CommonSpecChia{}, k8schianetv1.SpecChiaExporter{}, "ca", "", "ca"))
+	assert.Empty(t, SecretRefs(k8schianetv1.CommonSpecChia{}, k8schianetv1.SpecChiaExporter{}, ""))
+}
+
+func TestConfigMapRefs(t *testing.T) {
+	assert.Equal(t, []string{"mainnet"}, ConfigMapRefs(k8schianetv1.CommonSpecChia{ChiaNetwork: ptr.To("mainnet")}))
+	assert.Empty(t, ConfigMapRefs(k8schianetv1.CommonSpecChia{ChiaNetwork: ptr.To("")}))
+	assert.Empty(t, ConfigMapRefs(k8schianetv1.CommonSpecChia{}))
+}
+
+func TestSetChecksumAnnotations(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnemonic", Namespace: "default"},
+		Data:       map[string][]byte{"key.txt": []byte("abandon abandon")},
+	}
+	configMap := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "mainnet", Namespace: "default"},
+		Data:       map[string]string{"network_name": "mainnet"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&secret, &configMap).Build()
+	ctx := context.Background()
+
+	shared := map[string]string{"team": "farming"}
+	template := corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: shared}}
+	require.NoError(t, SetChecksumAnnotations(ctx, c, "default", &template, []string{"mnemonic", "missing"}, []string{"mainnet"}))
+	assert.Len(t, template.Annotations, 3)
+	assert.Equal(t, "farming", template.Annotations["team"])
+	secretChecksum := template.Annotations["checksum.k8s.chia.net/secret-mnemonic"]
+	assert.Len(t, secretChecksum, 64)
+	assert.Len(t, template.Annotations["checksum.k8s.chia.net/configmap-mainnet"], 64)
+
+	// The annotations shared with the resource's spec are left alone
+	assert.Equal(t, map[string]string{"team": "farming"}, shared)
+
+	// Changing a Secret's data changes its checksum
+	secret.Data["key.txt"] = []byte("zoo zoo")
+	require.NoError(t, c.Update(ctx, &secret))
+	template = corev1.PodTemplateSpec{}
+	require.NoError(t, SetChecksumAnnotations(ctx, c, "default", &template, []string{"mnemonic"}, nil))
+	assert.NotEqual(t, secretChecksum, template.Annotations["checksum.k8s.chia.net/secret-mnemonic"])
+}
+
+func TestChecksumAnnotation(t *testing.T) {
+	assert.Equal(t, "checksum.k8s.chia.net/secret-ca", checksumAnnotation("secret", "ca"))
+
+	long := strings.Repeat("a", 60) + ".b"
+	key := checksumAnnotation("configmap", long)
+	name := strings.TrimPrefix(key, ChecksumAnnotationPrefix)
+	assert.LessOrEqual(t, len(name), 63)
+	assert.NotEqual(t, key, checksumAnnotation("configmap", strings.Repeat("a", 60)+".c"))
+}
+
+func TestChecksumData(t *testing.T) {
+	assert.Equal(t,
+		checksumData(map[string][]byte{"a": []byte("1"), "b": []byte("2")}),
+		checksumData(map[string][]byte{"b": []byte("2"), "a": []byte("1")}),
+	)
+	assert.NotEqual(t,
+		checksumData(map[string][]byte{"a": []byte("1b")}),
+		checksumData(map[string][]byte{"a": []byte("1"), "b": nil}),
+	)
+}
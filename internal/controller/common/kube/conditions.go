@@ -0,0 +1,149 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"fmt"
+	"strings"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition reasons shared by the controllers. Reasons ending in Failed name the kind of subresource that couldn't be reconciled.
+const (
+	// ReasonReconciled means every subresource of the resource was reconciled
+	ReasonReconciled = "Reconciled"
+
+	// ReasonChiaNetworkFailed means the ChiaNetwork the resource references couldn't be read
+	ReasonChiaNetworkFailed = "ChiaNetworkFailed"
+
+	// ReasonConfigMapFailed means a ConfigMap couldn't be reconciled
+	ReasonConfigMapFailed = "ConfigMapFailed"
+
+	// ReasonServiceFailed means a Service couldn't be reconciled
+	ReasonServiceFailed = "ServiceFailed"
+
+	// ReasonIngressFailed means an Ingress couldn't be reconciled
+	ReasonIngressFailed = "IngressFailed"
+
+	// ReasonPersistentVolumeClaimFailed means a PersistentVolumeClaim couldn't be reconciled
+	ReasonPersistentVolumeClaimFailed = "PersistentVolumeClaimFailed"
+
+	// ReasonDeploymentFailed means a Deployment couldn't be reconciled
+	ReasonDeploymentFailed = "DeploymentFailed"
+
+	// ReasonStatefulSetFailed means a StatefulSet couldn't be reconciled
+	ReasonStatefulSetFailed = "StatefulSetFailed"
+
+	// ReasonPodDisruptionBudgetFailed means a PodDisruptionBudget couldn't be reconciled
+	ReasonPodDisruptionBudgetFailed = "PodDisruptionBudgetFailed"
+
+	// ReasonHorizontalPodAutoscalerFailed means a HorizontalPodAutoscaler couldn't be reconciled
+	ReasonHorizontalPodAutoscalerFailed = "HorizontalPodAutoscalerFailed"
+
+	// ReasonRolloutComplete means the resource's workload has rolled out and all of its replicas are available
+	ReasonRolloutComplete = "RolloutComplete"
+
+	// ReasonRollingOut means the resource's workload is still rolling out
+	ReasonRollingOut = "RollingOut"
+
+	// ReasonPodsFailing means some of the workload's pods can't start or keep running
+	ReasonPodsFailing = "PodsFailing"
+
+	// ReasonRolloutStalled means the workload's rollout can't make progress on its own
+	ReasonRolloutStalled = "RolloutStalled"
+
+	// ReasonRecreating means the resource's workload is being recreated, because its selector changed
+	ReasonRecreating = "Recreating"
+)
+
+// ReconcileStep returns the step of a reconcile that a Failed reason names, such as "service" for ReasonServiceFailed, for labeling reconcile error metrics
+func ReconcileStep(reason string) string {
+	switch reason {
+	case ReasonPersistentVolumeClaimFailed:
+		return "pvc"
+	case ReasonPodDisruptionBudgetFailed:
+		return "pdb"
+	case ReasonHorizontalPodAutoscalerFailed:
+		return "hpa"
+	}
+	return strings.ToLower(strings.TrimSuffix(reason, "Failed"))
+}
+
+// SetCondition sets a status condition observed at the resource's generation. Returns true if the condition changed.
+func SetCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) bool {
+	return meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// SetReadyConditions sets the Ready condition True, and the Progressing and Degraded conditions False. Returns true if any condition changed.
+func SetReadyConditions(conditions *[]metav1.Condition, generation int64, reason, message string) bool {
+	changed := SetCondition(conditions, generation, k8schianetv1.ConditionTypeReady, metav1.ConditionTrue, reason, message)
+	changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionFalse, reason, message) || changed
+	changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionFalse, reason, message) || changed
+	return changed
+}
+
+// SetProgressingConditions sets the Progressing condition True and the Ready condition False, for a resource that's waiting on something before it can be ready.
+// Returns true if any condition changed.
+func SetProgressingConditions(conditions *[]metav1.Condition, generation int64, reason, message string) bool {
+	changed := SetCondition(conditions, generation, k8schianetv1.ConditionTypeReady, metav1.ConditionFalse, reason, message)
+	changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionTrue, reason, message) || changed
+	return changed
+}
+
+// SetRecreatingConditions sets the Progressing condition True for a resource whose workload is being recreated.
+// The Ready condition is left alone, since the workload's pods keep running through the recreation. Returns true if any condition changed.
+func SetRecreatingConditions(conditions *[]metav1.Condition, generation int64, kind string, recreation k8schianetv1.WorkloadRecreation) bool {
+	message := fmt.Sprintf("%s is being recreated for its new selector, waiting for the old %s to be deleted", kind, kind)
+	if recreation.Phase == k8schianetv1.WorkloadRecreationAdopting {
+		message = fmt.Sprintf("%s is being recreated for its new selector, adopting the old %s's pods", kind, kind)
+	}
+	return SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionTrue, ReasonRecreating, message)
+}
+
+// SetFailedConditions sets the Degraded condition True and the Progressing condition False, for a resource with a subresource that couldn't be reconciled.
+// The Ready condition is left alone, since the resource's workload may still be running. Returns true if any condition changed.
+func SetFailedConditions(conditions *[]metav1.Condition, generation int64, reason, message string) bool {
+	changed := SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionFalse, reason, message)
+	changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionTrue, reason, message) || changed
+	return changed
+}
+
+// SetRolloutConditions sets the Ready, Progressing, and Degraded conditions from the rollout of a resource's workload.
+// Ready is only True once the rollout is complete, and Degraded is True while pods are failing or the rollout is stalled.
+// Returns true if any condition changed.
+func SetRolloutConditions(conditions *[]metav1.Condition, generation int64, rollout WorkloadRollout) bool {
+	replicas := fmt.Sprintf("%d of %d replicas updated, %d available", rollout.Status.UpdatedReplicas, rollout.Status.Replicas, rollout.Status.AvailableReplicas)
+	if rollout.Ready() {
+		return SetReadyConditions(conditions, generation, ReasonRolloutComplete, fmt.Sprintf("%s rolled out, %s", rollout.Kind, replicas))
+	}
+
+	changed := SetCondition(conditions, generation, k8schianetv1.ConditionTypeReady, metav1.ConditionFalse, ReasonRollingOut, fmt.Sprintf("%s is rolling out, %s", rollout.Kind, replicas))
+	switch {
+	case rollout.Stalled != "":
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionFalse, ReasonRolloutStalled, rollout.Stalled) || changed
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionTrue, ReasonRolloutStalled, rollout.Stalled) || changed
+	case len(rollout.Status.FailingPods) > 0:
+		var failing []string
+		for _, pod := range rollout.Status.FailingPods {
+			failing = append(failing, fmt.Sprintf("%s (%s)", pod.Name, pod.Reason))
+		}
+		message := fmt.Sprintf("Pods failing: %s", strings.Join(failing, ", "))
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionTrue, ReasonRollingOut, fmt.Sprintf("%s is rolling out, %s", rollout.Kind, replicas)) || changed
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionTrue, ReasonPodsFailing, message) || changed
+	default:
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeProgressing, metav1.ConditionTrue, ReasonRollingOut, fmt.Sprintf("%s is rolling out, %s", rollout.Kind, replicas)) || changed
+		changed = SetCondition(conditions, generation, k8schianetv1.ConditionTypeDegraded, metav1.ConditionFalse, ReasonRollingOut, "No pods are failing") || changed
+	}
+	return changed
+}
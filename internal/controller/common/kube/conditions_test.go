@@ -0,0 +1,144 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetReadyConditions(t *testing.T) {
+	var conditions []metav1.Condition
+
+	assert.True(t, SetReadyConditions(&conditions, 2, ReasonReconciled, "reconciled"))
+	assert.True(t, meta.IsStatusConditionTrue(conditions, k8schianetv1.ConditionTypeReady))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeDegraded))
+	for _, condition := range conditions {
+		assert.Equal(t, int64(2), condition.ObservedGeneration)
+		assert.Equal(t, ReasonReconciled, condition.Reason)
+	}
+
+	// Setting the same conditions again is not a change
+	assert.False(t, SetReadyConditions(&conditions, 2, ReasonReconciled, "reconciled"))
+
+	// A new generation is a change
+	assert.True(t, SetReadyConditions(&conditions, 3, ReasonReconciled, "reconciled"))
+}
+
+func TestSetProgressingConditions(t *testing.T) {
+	var conditions []metav1.Condition
+	SetReadyConditions(&conditions, 1, ReasonReconciled, "reconciled")
+
+	assert.True(t, SetProgressingConditions(&conditions, 1, "Waiting", "waiting"))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeReady))
+	assert.True(t, meta.IsStatusConditionTrue(conditions, k8schianetv1.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeDegraded))
+	assert.False(t, SetProgressingConditions(&conditions, 1, "Waiting", "waiting"))
+}
+
+func TestSetFailedConditions(t *testing.T) {
+	var conditions []metav1.Condition
+	SetReadyConditions(&conditions, 1, ReasonReconciled, "reconciled")
+
+	assert.True(t, SetFailedConditions(&conditions, 1, ReasonServiceFailed, "service failed"))
+	degraded := meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeDegraded)
+	require.NotNil(t, degraded)
+	assert.Equal(t, metav1.ConditionTrue, degraded.Status)
+	assert.Equal(t, ReasonServiceFailed, degraded.Reason)
+	assert.Equal(t, "service failed", degraded.Message)
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeProgressing))
+
+	// Ready is left alone, since the workload may still be running
+	ready := meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, ReasonReconciled, ready.Reason)
+
+	// Recovering clears Degraded
+	assert.True(t, SetReadyConditions(&conditions, 1, ReasonReconciled, "reconciled"))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeDegraded))
+}
+
+func TestSetRecreatingConditions(t *testing.T) {
+	var conditions []metav1.Condition
+	SetReadyConditions(&conditions, 1, ReasonReconciled, "reconciled")
+
+	recreation := k8schianetv1.WorkloadRecreation{Phase: k8schianetv1.WorkloadRecreationDeleting}
+	assert.True(t, SetRecreatingConditions(&conditions, 2, "StatefulSet", recreation))
+	progressing := meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeProgressing)
+	require.NotNil(t, progressing)
+	assert.Equal(t, metav1.ConditionTrue, progressing.Status)
+	assert.Equal(t, ReasonRecreating, progressing.Reason)
+	assert.Equal(t, "StatefulSet is being recreated for its new selector, waiting for the old StatefulSet to be deleted", progressing.Message)
+
+	// Ready is left alone, since the pods keep running
+	assert.True(t, meta.IsStatusConditionTrue(conditions, k8schianetv1.ConditionTypeReady))
+
+	recreation.Phase = k8schianetv1.WorkloadRecreationAdopting
+	assert.True(t, SetRecreatingConditions(&conditions, 2, "StatefulSet", recreation))
+	assert.Equal(t, "StatefulSet is being recreated for its new selector, adopting the old StatefulSet's pods", meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeProgressing).Message)
+}
+
+func TestSetRolloutConditions(t *testing.T) {
+	var conditions []metav1.Condition
+	rollout := WorkloadRollout{
+		Kind: "Deployment",
+		Status: k8schianetv1.WorkloadStatus{
+			Replicas:          1,
+			AvailableReplicas: 0,
+			UpdatedReplicas:   1,
+		},
+	}
+
+	assert.True(t, SetRolloutConditions(&conditions, 1, rollout))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeReady))
+	assert.True(t, meta.IsStatusConditionTrue(conditions, k8schianetv1.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeDegraded))
+
+	rollout.Status.FailingPods = []k8schianetv1.FailingPod{{Name: "pod-a", Reason: "CrashLoopBackOff"}}
+	assert.True(t, SetRolloutConditions(&conditions, 1, rollout))
+	degraded := meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeDegraded)
+	require.NotNil(t, degraded)
+	assert.Equal(t, metav1.ConditionTrue, degraded.Status)
+	assert.Equal(t, ReasonPodsFailing, degraded.Reason)
+	assert.Equal(t, "Pods failing: pod-a (CrashLoopBackOff)", degraded.Message)
+
+	rollout.Stalled = "ReplicaSet has timed out progressing."
+	assert.True(t, SetRolloutConditions(&conditions, 1, rollout))
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeProgressing))
+	assert.Equal(t, ReasonRolloutStalled, meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeDegraded).Reason)
+
+	rollout = WorkloadRollout{
+		Kind:     "Deployment",
+		Complete: true,
+		Status: k8schianetv1.WorkloadStatus{
+			Replicas:          1,
+			AvailableReplicas: 1,
+			UpdatedReplicas:   1,
+		},
+	}
+	assert.True(t, SetRolloutConditions(&conditions, 1, rollout))
+	ready := meta.FindStatusCondition(conditions, k8schianetv1.ConditionTypeReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, ReasonRolloutComplete, ready.Reason)
+	assert.True(t, meta.IsStatusConditionFalse(conditions, k8schianetv1.ConditionTypeDegraded))
+	assert.False(t, SetRolloutConditions(&conditions, 1, rollout))
+}
+
+func TestReconcileStep(t *testing.T) {
+	assert.Equal(t, "service", ReconcileStep(ReasonServiceFailed))
+	assert.Equal(t, "pvc", ReconcileStep(ReasonPersistentVolumeClaimFailed))
+	assert.Equal(t, "pdb", ReconcileStep(ReasonPodDisruptionBudgetFailed))
+	assert.Equal(t, "hpa", ReconcileStep(ReasonHorizontalPodAutoscalerFailed))
+	assert.Equal(t, "statefulset", ReconcileStep(ReasonStatefulSetFailed))
+	assert.Equal(t, "chianetwork", ReconcileStep(ReasonChiaNetworkFailed))
+}
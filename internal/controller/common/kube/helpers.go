@@ -7,10 +7,12 @@ package kube
 import (
 	"context"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"maps"
 	"sort"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -18,11 +20,34 @@ import (
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// chiaSSLDirs maps each Chia certificate-key pair name to its directory in CHIA_ROOT/config/ssl
+var chiaSSLDirs = map[string]string{
+	"private_crawler":    "crawler",
+	"private_daemon":     "daemon",
+	"private_data_layer": "data_layer",
+	"public_data_layer":  "data_layer",
+	"private_farmer":     "farmer",
+	"public_farmer":      "farmer",
+	"private_full_node":  "full_node",
+	"public_full_node":   "full_node",
+	"private_harvester":  "harvester",
+	"public_introducer":  "introducer",
+	"private_timelord":   "timelord",
+	"public_timelord":    "timelord",
+	"private_wallet":     "wallet",
+	"public_wallet":      "wallet",
+}
+
+// RestartedAtAnnotation is the pod template annotation used to trigger a rollout of a workload's Pods.
+// This is the same annotation `kubectl rollout restart` uses, and it is preserved by the Reconcile* functions so restarts aren't undone.
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
 // GetCommonLabels gives some common labels for chia-operator related objects
 func GetCommonLabels(kind string, meta metav1.ObjectMeta, additionalLabels ...map[string]string) map[string]string {
 	labels := CombineMaps(additionalLabels...)
@@ -67,6 +92,24 @@ func ShouldMakeService(srv k8schianetv1.Service, def bool) bool {
 	return def
 }
 
+// ShouldMakePodDisruptionBudget returns true if the related PodDisruptionBudget was configured to be made. PodDisruptionBudgets are disabled by default.
+func ShouldMakePodDisruptionBudget(pdb *k8schianetv1.PodDisruptionBudgetConfig) bool {
+	return pdb != nil && pdb.Enabled != nil && *pdb.Enabled
+}
+
+// ShouldMakeHorizontalPodAutoscaler returns true if the related HorizontalPodAutoscaler was configured to be made. HorizontalPodAutoscalers are disabled by default.
+func ShouldMakeHorizontalPodAutoscaler(autoscaling *k8schianetv1.AutoscalingConfig) bool {
+	return autoscaling != nil && autoscaling.Enabled != nil && *autoscaling.Enabled
+}
+
+// GetMaxReplicas returns the most replicas a workload can run, which is the HorizontalPodAutoscaler's maxReplicas while autoscaling is enabled
+func GetMaxReplicas(replicas int32, autoscaling *k8schianetv1.AutoscalingConfig) int32 {
+	if ShouldMakeHorizontalPodAutoscaler(autoscaling) {
+		return autoscaling.MaxReplicas
+	}
+	return replicas
+}
+
 // ShouldRollIntoMainPeerService returns true if the related Service's ports were meant to be rolled into the main peer Service's ports
 func ShouldRollIntoMainPeerService(srv k8schianetv1.Service) bool {
 	if srv.Enabled != nil && *srv.Enabled && srv.RollIntoPeerService != nil && *srv.RollIntoPeerService {
@@ -170,6 +213,98 @@ func GetExistingChiaRootVolume(storage *k8schianetv1.StorageConfig) corev1.Volum
 	}
 }
 
+// ShouldMountChiaCertificates returns true if pre-generated certificates should be copied into CHIA_ROOT/config/ssl
+func ShouldMountChiaCertificates(commonSpecChia k8schianetv1.CommonSpecChia) bool {
+	return commonSpecChia.CertificatesSecretName != nil && *commonSpecChia.CertificatesSecretName != ""
+}
+
+// GetChiaCertificatesVolume returns a projected Volume that lays out the pre-generated certificates Secret, and the CA Secret if given, like CHIA_ROOT/config/ssl.
+// Both Secrets are required, so a missing Secret holds the Pod back instead of leaving chia to generate a CA of its own.
+// Keys missing from the certificates Secret are skipped, so a certificates Secret limited to a subset of services can be mounted.
+func GetChiaCertificatesVolume(commonSpecChia k8schianetv1.CommonSpecChia, caSecretName *string) corev1.Volume {
+	keyMode := int32(0600)
+	crtMode := int32(0644)
+
+	var sources []corev1.VolumeProjection
+	if caSecretName != nil && *caSecretName != "" {
+		var items []corev1.KeyToPath
+		for _, name := range []string{"chia_ca", "private_ca"} {
+			items = append(items,
+				corev1.KeyToPath{Key: name + ".crt", Path: "ca/" + name + ".crt", Mode: &crtMode},
+				corev1.KeyToPath{Key: name + ".key", Path: "ca/" + name + ".key", Mode: &keyMode},
+			)
+		}
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: *caSecretName,
+				},
+				Items: items,
+			},
+		})
+	}
+
+	// Sort the certificate names so the order of the items doesn't trigger a rollout
+	var names []string
+	for name := range chiaSSLDirs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var items []corev1.KeyToPath
+	for _, name := range names {
+		dir := chiaSSLDirs[name]
+		items = append(items,
+			corev1.KeyToPath{Key: name + ".crt", Path: dir + "/" + name + ".crt", Mode: &crtMode},
+			corev1.KeyToPath{Key: name + ".key", Path: dir + "/" + name + ".key", Mode: &keyMode},
+		)
+	}
+	sources = append(sources, corev1.VolumeProjection{
+		Secret: &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: *commonSpecChia.CertificatesSecretName,
+			},
+			Items: items,
+		},
+	})
+
+	return corev1.Volume{
+		Name: "chia-certificates",
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	}
+}
+
+// GetChiaCertificatesVolumeMount returns the VolumeMount for the Volume from GetChiaCertificatesVolume
+func GetChiaCertificatesVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      "chia-certificates",
+		MountPath: "/chia-certificates",
+		ReadOnly:  true,
+	}
+}
+
+// GetChiaCertificatesInitContainer returns an init container that copies the pre-generated certificates into CHIA_ROOT/config/ssl.
+// The certificates are copied rather than mounted in place so chia can still write the certificates for services the certificates Secret leaves out, signed by the copied CA.
+func GetChiaCertificatesInitContainer(chiaContainer corev1.Container) corev1.Container {
+	return corev1.Container{
+		Name:            "chia-certificates",
+		Image:           chiaContainer.Image,
+		ImagePullPolicy: chiaContainer.ImagePullPolicy,
+		SecurityContext: chiaContainer.SecurityContext,
+		Command: []string{
+			"/bin/sh",
+			"-c",
+			// The glob skips the hidden ..data directories the kubelet keeps in projected volumes
+			"mkdir -p /chia-data/config/ssl && cp -RL /chia-certificates/* /chia-data/config/ssl/",
+		},
+		VolumeMounts: chiaContainer.VolumeMounts,
+	}
+}
+
 // GetCommonChiaEnv retrieves the environment variables from the CommonSpecChia config struct
 func GetCommonChiaEnv(commonSpecChia k8schianetv1.CommonSpecChia, networkData *map[string]string) ([]corev1.EnvVar, error) {
 	var env []corev1.EnvVar
@@ -180,11 +315,14 @@ func GetCommonChiaEnv(commonSpecChia k8schianetv1.CommonSpecChia, networkData *m
 		Value: "/chia-data",
 	})
 
-	// ca env var
-	env = append(env, corev1.EnvVar{
-		Name:  "ca",
-		Value: "/chia-ca",
-	})
+	// ca env var, which has the chia container generate its certificates from the CA.
+	// Left out if pre-generated certificates are copied into CHIA_ROOT, since it would have chia overwrite them.
+	if !ShouldMountChiaCertificates(commonSpecChia) {
+		env = append(env, corev1.EnvVar{
+			Name:  "ca",
+			Value: "/chia-ca",
+		})
+	}
 
 	// testnet env var
 	if commonSpecChia.Testnet != nil && *commonSpecChia.Testnet {
@@ -371,3 +509,175 @@ func ChiaExporterEnabled(in k8schianetv1.SpecChiaExporter) bool {
 	}
 	return *in.Enabled
 }
+
+// AccessModesShareable returns true if a PersistentVolumeClaim with the given access modes can be mounted by pods on several nodes at once
+func AccessModesShareable(accessModes []corev1.PersistentVolumeAccessMode) bool {
+	for _, mode := range accessModes {
+		if mode == corev1.ReadWriteMany || mode == corev1.ReadOnlyMany {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckVolumeClaimsShareable returns an error if any of the volumes is a PersistentVolumeClaim that can only be mounted on one node at a time, such as a ReadWriteOnce claim.
+// The pods of a workload with more than one replica can be scheduled to different nodes, and the ones that aren't on the claim's node would never start.
+// Claims that don't exist yet are skipped, since their access modes aren't known.
+func CheckVolumeClaimsShareable(ctx context.Context, c client.Client, namespace string, volumes []corev1.Volume) error {
+	for _, volume := range volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		var pvc corev1.PersistentVolumeClaim
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: volume.PersistentVolumeClaim.ClaimName}, &pvc)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting PersistentVolumeClaim \"%s\": %v", volume.PersistentVolumeClaim.ClaimName, err)
+		}
+		if !AccessModesShareable(pvc.Spec.AccessModes) {
+			return fmt.Errorf("PersistentVolumeClaim \"%s\" can't be shared by more than one replica, it needs the ReadWriteMany access mode", pvc.Name)
+		}
+	}
+	return nil
+}
+
+// PodSpecMountsSecret returns true if any volume in the pod spec sources its data from the named Secret
+func PodSpecMountsSecret(spec corev1.PodSpec, secretName string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == secretName {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil && source.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// RestartSecretConsumers rolls out every Deployment and StatefulSet in the namespace that mounts the named Secret.
+// This works the same way as `kubectl rollout restart`, by stamping an annotation on the pod template.
+// Workloads that were already restarted, or created, at or after restartedAt are skipped, so calling it again with the same time only retries the restarts that didn't happen.
+func RestartSecretConsumers(ctx context.Context, c client.Client, namespace, secretName string, restartedAt time.Time) error {
+	listOpts := &client.ListOptions{
+		Namespace: namespace,
+	}
+
+	var deployments appsv1.DeploymentList
+	if err := c.List(ctx, &deployments, listOpts); err != nil {
+		return fmt.Errorf("listing Deployments: %v", err)
+	}
+	for _, deploy := range deployments.Items {
+		if !PodSpecMountsSecret(deploy.Spec.Template.Spec, secretName) || restartedSince(deploy.ObjectMeta, deploy.Spec.Template, restartedAt) {
+			continue
+		}
+		patch := client.MergeFrom(deploy.DeepCopy())
+		if deploy.Spec.Template.Annotations == nil {
+			deploy.Spec.Template.Annotations = make(map[string]string)
+		}
+		deploy.Spec.Template.Annotations[RestartedAtAnnotation] = restartedAt.Format(time.RFC3339)
+		if err := c.Patch(ctx, &deploy, patch); err != nil {
+			return fmt.Errorf("restarting Deployment \"%s\": %v", deploy.Name, err)
+		}
+	}
+
+	var statefulsets appsv1.StatefulSetList
+	if err := c.List(ctx, &statefulsets, listOpts); err != nil {
+		return fmt.Errorf("listing StatefulSets: %v", err)
+	}
+	for _, stateful := range statefulsets.Items {
+		if !PodSpecMountsSecret(stateful.Spec.Template.Spec, secretName) || restartedSince(stateful.ObjectMeta, stateful.Spec.Template, restartedAt) {
+			continue
+		}
+		patch := client.MergeFrom(stateful.DeepCopy())
+		if stateful.Spec.Template.Annotations == nil {
+			stateful.Spec.Template.Annotations = make(map[string]string)
+		}
+		stateful.Spec.Template.Annotations[RestartedAtAnnotation] = restartedAt.Format(time.RFC3339)
+		if err := c.Patch(ctx, &stateful, patch); err != nil {
+			return fmt.Errorf("restarting StatefulSet \"%s\": %v", stateful.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// restartedSince returns true if a workload was created or restarted at or after the given time.
+// The restartedAt annotation only has second precision, so the time is truncated to the second before comparing.
+func restartedSince(meta metav1.ObjectMeta, template corev1.PodTemplateSpec, since time.Time) bool {
+	since = since.Truncate(time.Second)
+	if !meta.CreationTimestamp.IsZero() && !meta.CreationTimestamp.Time.Before(since) {
+		return true
+	}
+	restartedAt, err := time.Parse(time.RFC3339, template.Annotations[RestartedAtAnnotation])
+	return err == nil && !restartedAt.Before(since)
+}
+
+// SecretConsumersRolledOut returns true if every Deployment and StatefulSet in the namespace that mounts the named Secret has finished rolling out
+func SecretConsumersRolledOut(ctx context.Context, c client.Client, namespace, secretName string) (bool, error) {
+	listOpts := &client.ListOptions{
+		Namespace: namespace,
+	}
+
+	var deployments appsv1.DeploymentList
+	if err := c.List(ctx, &deployments, listOpts); err != nil {
+		return false, fmt.Errorf("listing Deployments: %v", err)
+	}
+	for _, deploy := range deployments.Items {
+		if PodSpecMountsSecret(deploy.Spec.Template.Spec, secretName) && !DeploymentRolledOut(deploy) {
+			return false, nil
+		}
+	}
+
+	var statefulsets appsv1.StatefulSetList
+	if err := c.List(ctx, &statefulsets, listOpts); err != nil {
+		return false, fmt.Errorf("listing StatefulSets: %v", err)
+	}
+	for _, stateful := range statefulsets.Items {
+		if PodSpecMountsSecret(stateful.Spec.Template.Spec, secretName) && !StatefulSetRolledOut(stateful) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// DeploymentRolledOut returns true if the Deployment controller has observed the latest spec and all replicas are updated and available
+func DeploymentRolledOut(deploy appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	return deploy.Status.ObservedGeneration >= deploy.Generation &&
+		deploy.Status.UpdatedReplicas == replicas &&
+		deploy.Status.AvailableReplicas == replicas &&
+		deploy.Status.Replicas == replicas
+}
+
+// StatefulSetRolledOut returns true if the StatefulSet controller has observed the latest spec and all replicas are updated and ready
+func StatefulSetRolledOut(stateful appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if stateful.Spec.Replicas != nil {
+		replicas = *stateful.Spec.Replicas
+	}
+	return stateful.Status.ObservedGeneration >= stateful.Generation &&
+		stateful.Status.UpdatedReplicas == replicas &&
+		stateful.Status.ReadyReplicas == replicas &&
+		stateful.Status.CurrentRevision == stateful.Status.UpdateRevision
+}
+
+// FirstPEMBlock returns the first PEM block in the data, re-encoded to PEM, or nil if there are no PEM blocks.
+// Useful for reading the signing certificate from a CA bundle that contains multiple certificates.
+func FirstPEMBlock(data []byte) []byte {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil
+	}
+	return pem.EncodeToMemory(block)
+}
@@ -1,13 +1,21 @@
 package kube
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/chia-network/chia-operator/internal/controller/common/consts"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestGetCommonLabels(t *testing.T) {
@@ -116,6 +124,59 @@ func TestShouldMakeService(t *testing.T) {
 	require.Equal(t, false, actual, "expected should not make Service, defaulted to false with Enabled=false")
 }
 
+func TestShouldMakePodDisruptionBudget(t *testing.T) {
+	require.False(t, ShouldMakePodDisruptionBudget(nil), "expected should not make PodDisruptionBudget when unset")
+	require.False(t, ShouldMakePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{}), "expected should not make PodDisruptionBudget with Enabled=nil")
+	require.False(t, ShouldMakePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{Enabled: ptr.To(false)}), "expected should not make PodDisruptionBudget with Enabled=false")
+	require.True(t, ShouldMakePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{Enabled: ptr.To(true)}), "expected should make PodDisruptionBudget with Enabled=true")
+}
+
+func TestShouldMakeHorizontalPodAutoscaler(t *testing.T) {
+	require.False(t, ShouldMakeHorizontalPodAutoscaler(nil), "expected should not make HorizontalPodAutoscaler when unset")
+	require.False(t, ShouldMakeHorizontalPodAutoscaler(&k8schianetv1.AutoscalingConfig{MaxReplicas: 3}), "expected should not make HorizontalPodAutoscaler with Enabled=nil")
+	require.True(t, ShouldMakeHorizontalPodAutoscaler(&k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true), MaxReplicas: 3}), "expected should make HorizontalPodAutoscaler with Enabled=true")
+}
+
+func TestGetMaxReplicas(t *testing.T) {
+	require.Equal(t, int32(2), GetMaxReplicas(2, nil))
+	require.Equal(t, int32(2), GetMaxReplicas(2, &k8schianetv1.AutoscalingConfig{Enabled: ptr.To(false), MaxReplicas: 5}), "expected replicas when autoscaling is disabled")
+	require.Equal(t, int32(5), GetMaxReplicas(1, &k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true), MaxReplicas: 5}), "expected maxReplicas when autoscaling is enabled")
+}
+
+func TestAccessModesShareable(t *testing.T) {
+	require.False(t, AccessModesShareable(nil))
+	require.False(t, AccessModesShareable([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadWriteOncePod}))
+	require.True(t, AccessModesShareable([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadWriteMany}))
+	require.True(t, AccessModesShareable([]corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}))
+}
+
+func TestCheckVolumeClaimsShareable(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	claim := func(name string, accessMode corev1.PersistentVolumeAccessMode) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{accessMode}},
+		}
+	}
+	volume := func(claimName string) corev1.Volume {
+		return corev1.Volume{
+			Name:         claimName,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName}},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claim("shared", corev1.ReadWriteMany), claim("single", corev1.ReadWriteOnce)).Build()
+	ctx := context.Background()
+
+	require.NoError(t, CheckVolumeClaimsShareable(ctx, c, "default", []corev1.Volume{
+		volume("shared"),
+		volume("missing"),
+		{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}))
+	require.ErrorContains(t, CheckVolumeClaimsShareable(ctx, c, "default", []corev1.Volume{volume("shared"), volume("single")}), `PersistentVolumeClaim "single"`)
+}
+
 func TestShouldRollIntoMainPeerService(t *testing.T) {
 	enabled := true
 	disabled := false
@@ -391,3 +452,223 @@ func TestChiaExporterEnabled(t *testing.T) {
 	})
 	require.Equal(t, false, actual, "expected exporter disabled, set to false")
 }
+
+func TestGetChiaCertificatesVolume(t *testing.T) {
+	certsSecret := "test-certs"
+	caSecret := "test-ca"
+	commonSpecChia := k8schianetv1.CommonSpecChia{
+		CertificatesSecretName: &certsSecret,
+	}
+	require.True(t, ShouldMountChiaCertificates(commonSpecChia))
+	require.False(t, ShouldMountChiaCertificates(k8schianetv1.CommonSpecChia{}))
+
+	volume := GetChiaCertificatesVolume(commonSpecChia, &caSecret)
+	require.Equal(t, "chia-certificates", volume.Name)
+	require.NotNil(t, volume.Projected)
+	require.Len(t, volume.Projected.Sources, 2)
+
+	caSource := volume.Projected.Sources[0].Secret
+	require.Equal(t, caSecret, caSource.Name)
+	require.Len(t, caSource.Items, 4)
+	require.Equal(t, "private_ca.key", caSource.Items[3].Key)
+	require.Equal(t, "ca/private_ca.key", caSource.Items[3].Path)
+	require.Equal(t, int32(0600), *caSource.Items[3].Mode)
+
+	certsSource := volume.Projected.Sources[1].Secret
+	require.Equal(t, certsSecret, certsSource.Name)
+	require.Nil(t, caSource.Optional, "a missing CA Secret should hold the Pod back")
+	require.Nil(t, certsSource.Optional, "a missing certificates Secret should hold the Pod back")
+	paths := make(map[string]string)
+	for _, item := range certsSource.Items {
+		paths[item.Key] = item.Path
+	}
+	require.Len(t, paths, 28)
+	require.Equal(t, "harvester/private_harvester.crt", paths["private_harvester.crt"])
+	require.Equal(t, "daemon/private_daemon.key", paths["private_daemon.key"])
+	require.Equal(t, "full_node/public_full_node.crt", paths["public_full_node.crt"])
+
+	// PodSpecMountsSecret finds both Secrets, so workloads are restarted when either changes
+	spec := corev1.PodSpec{Volumes: []corev1.Volume{volume}}
+	require.True(t, PodSpecMountsSecret(spec, certsSecret))
+	require.True(t, PodSpecMountsSecret(spec, caSecret))
+
+	// Without a CA Secret only the certificates are projected
+	volume = GetChiaCertificatesVolume(commonSpecChia, nil)
+	require.Len(t, volume.Projected.Sources, 1)
+}
+
+func TestGetChiaCertificatesInitContainer(t *testing.T) {
+	chiaContainer := corev1.Container{
+		Name:  "chia",
+		Image: "ghcr.io/chia-network/chia:latest",
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "chiaroot", MountPath: "/chia-data"},
+			GetChiaCertificatesVolumeMount(),
+		},
+	}
+
+	// The certificates aren't mounted over CHIA_ROOT/config/ssl, which chia needs to write to
+	require.NotEqual(t, "/chia-data/config/ssl", GetChiaCertificatesVolumeMount().MountPath)
+
+	init := GetChiaCertificatesInitContainer(chiaContainer)
+	require.Equal(t, "chia-certificates", init.Name)
+	require.Equal(t, chiaContainer.Image, init.Image)
+	require.Equal(t, chiaContainer.VolumeMounts, init.VolumeMounts)
+	require.Contains(t, init.Command[len(init.Command)-1], "/chia-data/config/ssl")
+}
+
+func TestGetCommonChiaEnv_Certificates(t *testing.T) {
+	hasCAEnv := func(env []corev1.EnvVar) bool {
+		for _, e := range env {
+			if e.Name == "ca" {
+				return true
+			}
+		}
+		return false
+	}
+
+	env, err := GetCommonChiaEnv(k8schianetv1.CommonSpecChia{}, nil)
+	require.NoError(t, err)
+	require.True(t, hasCAEnv(env))
+
+	// The chia container shouldn't generate certificates from the CA when pre-generated certificates are mounted
+	certsSecret := "test-certs"
+	env, err = GetCommonChiaEnv(k8schianetv1.CommonSpecChia{CertificatesSecretName: &certsSecret}, nil)
+	require.NoError(t, err)
+	require.False(t, hasCAEnv(env))
+}
+
+func TestPodSpecMountsSecret(t *testing.T) {
+	spec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "secret-ca",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: "test-certs",
+					},
+				},
+			},
+		},
+	}
+	require.True(t, PodSpecMountsSecret(spec, "test-certs"))
+	require.False(t, PodSpecMountsSecret(spec, "other-certs"))
+
+	projected := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "projected",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{
+								Secret: &corev1.SecretProjection{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "test-certs",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.True(t, PodSpecMountsSecret(projected, "test-certs"))
+}
+
+func TestRestartSecretConsumers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	renewedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	deployment := func(name string, created time.Time, annotations map[string]string, secretName string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name:         "certs",
+							VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretName}},
+						}},
+					},
+				},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		deployment("stale", renewedAt.Add(-time.Hour), nil, "certs"),
+		deployment("restarted", renewedAt.Add(-time.Hour), map[string]string{RestartedAtAnnotation: renewedAt.Add(time.Minute).Format(time.RFC3339)}, "certs"),
+		deployment("created-after", renewedAt.Add(time.Minute), nil, "certs"),
+		deployment("unrelated", renewedAt.Add(-time.Hour), nil, "other"),
+	).Build()
+	ctx := context.Background()
+
+	getTemplateAnnotations := func(name string) map[string]string {
+		var deploy appsv1.Deployment
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &deploy))
+		return deploy.Spec.Template.Annotations
+	}
+
+	require.NoError(t, RestartSecretConsumers(ctx, c, "default", "certs", renewedAt))
+	require.Equal(t, renewedAt.Format(time.RFC3339), getTemplateAnnotations("stale")[RestartedAtAnnotation], "a workload that hasn't restarted since should be restarted")
+	require.Equal(t, renewedAt.Add(time.Minute).Format(time.RFC3339), getTemplateAnnotations("restarted")[RestartedAtAnnotation], "a workload that restarted since shouldn't be restarted again")
+	require.Empty(t, getTemplateAnnotations("created-after"), "a workload created since shouldn't be restarted")
+	require.Empty(t, getTemplateAnnotations("unrelated"), "a workload that doesn't mount the Secret shouldn't be restarted")
+
+	// Retrying with the same time doesn't restart anything a second time
+	var before appsv1.Deployment
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "stale"}, &before))
+	require.NoError(t, RestartSecretConsumers(ctx, c, "default", "certs", renewedAt.Add(500*time.Millisecond)))
+	var after appsv1.Deployment
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "stale"}, &after))
+	require.Equal(t, before.ResourceVersion, after.ResourceVersion)
+}
+
+func TestDeploymentRolledOut(t *testing.T) {
+	replicas := int32(2)
+	deploy := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  2,
+		},
+	}
+	require.True(t, DeploymentRolledOut(deploy))
+
+	deploy.Status.UpdatedReplicas = 1
+	require.False(t, DeploymentRolledOut(deploy))
+
+	deploy.Status.UpdatedReplicas = 2
+	deploy.Status.ObservedGeneration = 1
+	require.False(t, DeploymentRolledOut(deploy))
+}
+
+func TestStatefulSetRolledOut(t *testing.T) {
+	stateful := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			CurrentRevision:    "rev-1",
+			UpdateRevision:     "rev-1",
+		},
+	}
+	require.True(t, StatefulSetRolledOut(stateful))
+
+	stateful.Status.UpdateRevision = "rev-2"
+	require.False(t, StatefulSetRolledOut(stateful))
+}
+
+func TestFirstPEMBlock(t *testing.T) {
+	first := "-----BEGIN CERTIFICATE-----\nAQID\n-----END CERTIFICATE-----\n"
+	second := "-----BEGIN CERTIFICATE-----\nBAUG\n-----END CERTIFICATE-----\n"
+	require.Equal(t, []byte(first), FirstPEMBlock([]byte(first+second)))
+	require.Nil(t, FirstPEMBlock([]byte("not pem data")))
+}
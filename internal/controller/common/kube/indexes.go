@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ChiaNetworkIndexField is the field index of the ChiaNetwork a Chia resource uses, which a change to a ChiaNetwork's ConfigMap is mapped back to its resources with
+const ChiaNetworkIndexField = ".spec.chia.chiaNetwork"
+
+// chiaNetworkIndexes are the kinds of Chia resources that can use a ChiaNetwork, with how to read the ChiaNetwork from each
+var chiaNetworkIndexes = []struct {
+	obj         client.Object
+	chiaNetwork func(client.Object) *string
+}{
+	{&k8schianetv1.ChiaCrawler{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaCrawler).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaDataLayer{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaDataLayer).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaFarmer{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaFarmer).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaHarvester{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaHarvester).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaIntroducer{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaIntroducer).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaNode{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaNode).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaSeeder{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaSeeder).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaTimelord{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaTimelord).Spec.ChiaConfig.ChiaNetwork }},
+	{&k8schianetv1.ChiaWallet{}, func(obj client.Object) *string { return obj.(*k8schianetv1.ChiaWallet).Spec.ChiaConfig.ChiaNetwork }},
+}
+
+// IndexChiaNetworks indexes every kind of Chia resource that can use a ChiaNetwork by the ChiaNetwork's name,
+// so the controllers can look up the resources that use a ChiaNetwork rather than listing all of them.
+// The index is shared by the controllers, so it's registered once with the manager's field indexer.
+func IndexChiaNetworks(ctx context.Context, indexer client.FieldIndexer) error {
+	for _, index := range chiaNetworkIndexes {
+		chiaNetwork := index.chiaNetwork
+		err := indexer.IndexField(ctx, index.obj, ChiaNetworkIndexField, func(obj client.Object) []string {
+			if name := chiaNetwork(obj); name != nil && *name != "" {
+				return []string{*name}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error indexing %T by ChiaNetwork: %v", index.obj, err)
+		}
+	}
+	return nil
+}
+
+// OwnedByChiaNetwork only passes events for objects controlled by a ChiaNetwork, which are the ConfigMaps ChiaNetworks generate
+var OwnedByChiaNetwork = predicate.NewPredicateFuncs(func(obj client.Object) bool {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != string(consts.ChiaNetworkKind) {
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	return err == nil && gv.Group == k8schianetv1.GroupVersion.Group
+})
+
+// IgnoreStatusUpdates only passes update events that changed a resource's generation, so writing a resource's status doesn't reconcile it again.
+// Controllers that poll RPC status write it on every reconcile, and would otherwise reconcile as fast as the RPC responds rather than on their interval.
+var IgnoreStatusUpdates = predicate.GenerationChangedPredicate{}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// fakeIndexer registers indexes with a fake client builder
+type fakeIndexer struct {
+	builder *fake.ClientBuilder
+}
+
+func (f fakeIndexer) IndexField(_ context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	f.builder.WithIndex(obj, field, extractValue)
+	return nil
+}
+
+func TestIndexChiaNetworks(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, k8schianetv1.AddToScheme(scheme))
+
+	mainnet := k8schianetv1.ChiaHarvester{ObjectMeta: metav1.ObjectMeta{Name: "mainnet-harvester", Namespace: "default"}}
+	mainnet.Spec.ChiaConfig.ChiaNetwork = ptr.To("mainnet")
+	testnet := k8schianetv1.ChiaHarvester{ObjectMeta: metav1.ObjectMeta{Name: "testnet-harvester", Namespace: "default"}}
+	testnet.Spec.ChiaConfig.ChiaNetwork = ptr.To("testnet11")
+	none := k8schianetv1.ChiaHarvester{ObjectMeta: metav1.ObjectMeta{Name: "harvester", Namespace: "default"}}
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&mainnet, &testnet, &none)
+	require.NoError(t, IndexChiaNetworks(context.Background(), fakeIndexer{builder: builder}))
+	c := builder.Build()
+
+	var list k8schianetv1.ChiaHarvesterList
+	require.NoError(t, c.List(context.Background(), &list, client.InNamespace("default"), client.MatchingFields{ChiaNetworkIndexField: "mainnet"}))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "mainnet-harvester", list.Items[0].Name)
+
+	// Every kind that can use a ChiaNetwork is indexed
+	var nodes k8schianetv1.ChiaNodeList
+	require.NoError(t, c.List(context.Background(), &nodes, client.MatchingFields{ChiaNetworkIndexField: "mainnet"}))
+	assert.Empty(t, nodes.Items)
+}
+
+func TestOwnedByChiaNetwork(t *testing.T) {
+	configMap := func(owner *metav1.OwnerReference) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mainnet"}}
+		if owner != nil {
+			cm.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return cm
+	}
+
+	owned := configMap(&metav1.OwnerReference{APIVersion: "k8s.chia.net/v1", Kind: "ChiaNetwork", Name: "mainnet", Controller: ptr.To(true)})
+	assert.True(t, OwnedByChiaNetwork.Create(event.CreateEvent{Object: owned}))
+	assert.True(t, OwnedByChiaNetwork.Update(event.UpdateEvent{ObjectOld: owned, ObjectNew: owned}))
+
+	assert.False(t, OwnedByChiaNetwork.Create(event.CreateEvent{Object: configMap(nil)}))
+	assert.False(t, OwnedByChiaNetwork.Create(event.CreateEvent{Object: configMap(&metav1.OwnerReference{APIVersion: "k8s.chia.net/v1", Kind: "ChiaNetwork", Name: "mainnet"})}))
+	assert.False(t, OwnedByChiaNetwork.Create(event.CreateEvent{Object: configMap(&metav1.OwnerReference{APIVersion: "example.com/v1", Kind: "ChiaNetwork", Name: "mainnet", Controller: ptr.To(true)})}))
+	assert.False(t, OwnedByChiaNetwork.Create(event.CreateEvent{Object: configMap(&metav1.OwnerReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "mainnet", Controller: ptr.To(true)})}))
+}
+
+func TestIgnoreStatusUpdates(t *testing.T) {
+	old := &k8schianetv1.ChiaNode{ObjectMeta: metav1.ObjectMeta{Name: "node", Generation: 1, ResourceVersion: "1"}}
+
+	statusUpdate := old.DeepCopy()
+	statusUpdate.ResourceVersion = "2"
+	statusUpdate.Status.Ready = true
+	assert.False(t, IgnoreStatusUpdates.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: statusUpdate}))
+
+	specUpdate := old.DeepCopy()
+	specUpdate.ResourceVersion = "2"
+	specUpdate.Generation = 2
+	assert.True(t, IgnoreStatusUpdates.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: specUpdate}))
+
+	assert.True(t, IgnoreStatusUpdates.Create(event.CreateEvent{Object: old}))
+	assert.True(t, IgnoreStatusUpdates.Delete(event.DeleteEvent{Object: old}))
+}
+
+// fakeControllerManager provides the options a controller reads from its manager, so a controller can be started without a cluster
+type fakeControllerManager struct {
+	manager.Manager
+}
+
+func (fakeControllerManager) GetControllerOptions() config.Controller {
+	return config.Controller{SkipNameValidation: ptr.To(true)}
+}
+
+func (fakeControllerManager) GetLogger() logr.Logger {
+	return logr.Discard()
+}
+
+func TestIgnoreStatusUpdates_ReconcilesOnInterval(t *testing.T) {
+	const interval = 200 * time.Millisecond
+	node := &k8schianetv1.ChiaNode{ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default", Generation: 1, ResourceVersion: "1"}}
+	updates := make(chan event.UpdateEvent, 100)
+
+	// The reconciler writes status on every reconcile like the RPC status controllers do, and the write is fed back to the controller as a watch event
+	var reconciles atomic.Int32
+	reconciler := reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		count := reconciles.Add(1)
+		updated := node.DeepCopy()
+		updated.ResourceVersion = strconv.Itoa(int(count) + 1)
+		updated.Status.FullNodes = []k8schianetv1.ChiaNodeFullNodeStatus{{Pod: "node-0", PeakHeight: int64(count)}}
+		updates <- event.UpdateEvent{ObjectOld: node, ObjectNew: updated}
+		return reconcile.Result{RequeueAfter: interval}, nil
+	})
+
+	c, err := controller.NewUnmanaged("ignore-status-updates", fakeControllerManager{}, controller.Options{Reconciler: reconciler})
+	require.NoError(t, err)
+	err = c.Watch(source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		eventHandler := &handler.EnqueueRequestForObject{}
+		eventHandler.Create(ctx, event.CreateEvent{Object: node}, queue)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case e := <-updates:
+					if IgnoreStatusUpdates.Update(e) {
+						eventHandler.Update(ctx, e, queue)
+					}
+				}
+			}
+		}()
+		return nil
+	}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*interval/2)
+	defer cancel()
+	require.NoError(t, c.Start(ctx))
+
+	// The first reconcile, then one for each interval that passed
+	assert.LessOrEqual(t, reconciles.Load(), int32(3))
+	assert.GreaterOrEqual(t, reconciles.Load(), int32(2))
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// ApplyPodTemplate applies a resource's podTemplate over the pod template generated for it, as a strategic merge patch.
+// Lists such as containers, volumes and tolerations are merged by their keys, so the chia container can be patched through a container named ChiaContainerName.
+// Fields that don't exist on a pod template are rejected, so a typo in a podTemplate doesn't go unnoticed.
+func ApplyPodTemplate(template *corev1.PodTemplateSpec, podTemplate *runtime.RawExtension) error {
+	if podTemplate == nil || len(podTemplate.Raw) == 0 {
+		return nil
+	}
+
+	original, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("error marshaling pod template: %v", err)
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, podTemplate.Raw, corev1.PodTemplateSpec{})
+	if err != nil {
+		return fmt.Errorf("error applying podTemplate: %v", err)
+	}
+
+	var result corev1.PodTemplateSpec
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&result); err != nil {
+		return fmt.Errorf("error applying podTemplate: %v", err)
+	}
+	*template = result
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func testPodTemplate() corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app.kubernetes.io/instance": "farmer"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  ChiaContainerName,
+					Image: "ghcr.io/chia-network/chia:latest",
+					Env:   []corev1.EnvVar{{Name: "service", Value: "farmer"}},
+				},
+				{
+					Name:  "chia-exporter",
+					Image: "ghcr.io/chia-network/chia-exporter:latest",
+				},
+			},
+			NodeSelector: map[string]string{"disk": "ssd"},
+		},
+	}
+}
+
+func TestApplyPodTemplate(t *testing.T) {
+	template := testPodTemplate()
+	err := ApplyPodTemplate(&template, &runtime.RawExtension{Raw: []byte(`{
+		"metadata": {"labels": {"team": "farming"}},
+		"spec": {
+			"priorityClassName": "chia",
+			"hostNetwork": true,
+			"terminationGracePeriodSeconds": 120,
+			"tolerations": [{"key": "dedicated", "operator": "Equal", "value": "chia", "effect": "NoSchedule"}],
+			"hostAliases": [{"ip": "10.0.0.1", "hostnames": ["node.local"]}],
+			"containers": [{"name": "chia", "env": [{"name": "extra", "value": "true"}]}]
+		}
+	}`)})
+	require.NoError(t, err)
+
+	// Added fields
+	assert.Equal(t, "chia", template.Spec.PriorityClassName)
+	assert.True(t, template.Spec.HostNetwork)
+	assert.Equal(t, ptr.To(int64(120)), template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "chia", Effect: corev1.TaintEffectNoSchedule}}, template.Spec.Tolerations)
+	assert.Equal(t, []corev1.HostAlias{{IP: "10.0.0.1", Hostnames: []string{"node.local"}}}, template.Spec.HostAliases)
+
+	// Merged fields
+	assert.Equal(t, map[string]string{"app.kubernetes.io/instance": "farmer", "team": "farming"}, template.Labels)
+	assert.Equal(t, map[string]string{"disk": "ssd"}, template.Spec.NodeSelector)
+	require.Len(t, template.Spec.Containers, 2)
+	assert.Equal(t, ChiaContainerName, template.Spec.Containers[0].Name)
+	assert.Equal(t, "ghcr.io/chia-network/chia:latest", template.Spec.Containers[0].Image)
+	assert.ElementsMatch(t, []corev1.EnvVar{{Name: "service", Value: "farmer"}, {Name: "extra", Value: "true"}}, template.Spec.Containers[0].Env)
+	assert.Equal(t, "chia-exporter", template.Spec.Containers[1].Name)
+}
+
+func TestApplyPodTemplate_Directives(t *testing.T) {
+	template := testPodTemplate()
+	err := ApplyPodTemplate(&template, &runtime.RawExtension{Raw: []byte(`{
+		"spec": {
+			"nodeSelector": {"$patch": "replace", "zone": "a"},
+			"containers": [{"name": "chia-exporter", "$patch": "delete"}]
+		}
+	}`)})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"zone": "a"}, template.Spec.NodeSelector)
+	require.Len(t, template.Spec.Containers, 1)
+	assert.Equal(t, ChiaContainerName, template.Spec.Containers[0].Name)
+}
+
+func TestApplyPodTemplate_Unset(t *testing.T) {
+	template := testPodTemplate()
+	require.NoError(t, ApplyPodTemplate(&template, nil))
+	assert.Equal(t, testPodTemplate(), template)
+
+	require.NoError(t, ApplyPodTemplate(&template, &runtime.RawExtension{}))
+	assert.Equal(t, testPodTemplate(), template)
+}
+
+func TestApplyPodTemplate_Invalid(t *testing.T) {
+	for name, raw := range map[string]string{
+		"unknown field": `{"spec": {"priorityClass": "chia"}}`,
+		"wrong type":    `{"spec": {"hostNetwork": "yes"}}`,
+		"not an object": `["spec"]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			template := testPodTemplate()
+			err := ApplyPodTemplate(&template, &runtime.RawExtension{Raw: []byte(raw)})
+			assert.Error(t, err)
+			assert.Equal(t, testPodTemplate(), template, "the pod template shouldn't change when the podTemplate can't be applied")
+		})
+	}
+}
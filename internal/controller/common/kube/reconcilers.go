@@ -8,19 +8,27 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"strings"
 	"time"
 
 	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/metrics"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -28,9 +36,20 @@ const (
 	// ObjectModifiedTryAgainError contains the error text for an error that can happen when multiple reconciliation loops are called for the same object at nearly the same time.
 	// When this happens, we just want to requeue the reconcile after some amount of time to ensure the latest changes were applied to the sub-resources
 	ObjectModifiedTryAgainError = "the object has been modified; please apply your changes to the latest version and try again"
+
+	// FieldManager is the field manager the operator server-side applies the objects it generates with
+	FieldManager = "chia-operator"
+
+	// pvcFieldManager is the field manager the operator server-side applies changes to existing PVCs with.
+	// FieldManager keeps ownership of the immutable spec fields a PVC was created with, so leaving them out of later applies doesn't try to remove them.
+	pvcFieldManager = FieldManager + "-pvc"
 )
 
-// ReconcileService uses the controller-runtime client to determine if the service resource needs to be created or updated
+// legacyFieldManagers are the field managers the operator created and updated objects with before it used server-side apply.
+// Without a field manager in the request, the API server names it after the operator's binary.
+var legacyFieldManagers = sets.New("manager")
+
+// ReconcileService uses the controller-runtime client to determine if the service resource needs to be applied or deleted
 func ReconcileService(ctx context.Context, c client.Client, service k8schianetv1.Service, desired corev1.Service, defaultEnabled bool) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("Service.Namespace", desired.Namespace, "Service.Name", desired.Name)
 	ensureServiceExists := ShouldMakeService(service, defaultEnabled)
@@ -43,222 +62,192 @@ func ReconcileService(ctx context.Context, c client.Client, service k8schianetv1
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
 		// Service not found - create if it should exist, or return here if it shouldn't
-		if ensureServiceExists {
-			klog.Info("Creating new Service")
-			if err := c.Create(ctx, &desired); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error creating Service \"%s\": %v", desired.Name, err)
-			}
-		} else {
+		if !ensureServiceExists {
 			return ctrl.Result{}, nil
 		}
+		klog.Info("Creating new Service")
 	} else if err != nil {
 		// Getting Service failed, but it wasn't because it doesn't exist, can't do anything
 		return ctrl.Result{}, fmt.Errorf("error getting existing Service \"%s\": %v", desired.Name, err)
-	} else {
-		// Service exists, so we need to update it if there are any changes, or delete if it was disabled
-		if ensureServiceExists {
-			desiredAnnotations := CombineMaps(current.Annotations, desired.Annotations)
-			if !reflect.DeepEqual(current.Spec, desired.Spec) || !reflect.DeepEqual(current.Labels, desired.Labels) || !reflect.DeepEqual(current.Annotations, desiredAnnotations) {
-				current.Labels = desired.Labels
-				current.Annotations = desiredAnnotations
-				current.Spec = desired.Spec
-				if err := c.Update(ctx, &current); err != nil {
-					if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-						return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-					}
-					return ctrl.Result{}, fmt.Errorf("error updating Service \"%s\": %v", desired.Name, err)
-				}
-			}
-		} else {
-			klog.Info("Deleting Service because it was disabled")
-			if err := c.Delete(ctx, &current); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error deleting Service \"%s\": %v", desired.Name, err)
-			}
+	} else if !ensureServiceExists {
+		klog.Info("Deleting Service because it was disabled")
+		if err := c.Delete(ctx, &current); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting Service \"%s\": %v", desired.Name, err)
 		}
+		metrics.RecordObjectAction(&current, "Service", metrics.ActionDelete)
+		return ctrl.Result{}, nil
 	}
 
-	return ctrl.Result{}, nil
+	// Fields the API server defaults, such as clusterIP and ipFamilies, aren't in the desired Service, so applying it leaves them alone
+	return applyObject(ctx, c, "Service", &current, &desired)
 }
 
-// ReconcileDeployment uses the controller-runtime client to determine if the deployment resource needs to be created or updated
-func ReconcileDeployment(ctx context.Context, c client.Client, desired appsv1.Deployment) (reconcile.Result, error) {
+// ReconcileDeployment uses the controller-runtime client to determine if the deployment resource needs to be applied.
+// A Deployment whose selector changed is recreated over several reconciles, which is recorded in the owning resource's workload status.
+// The reconcile should stop and requeue with the returned result while status.Recreation is set.
+func ReconcileDeployment(ctx context.Context, c client.Client, desired appsv1.Deployment, status *k8schianetv1.WorkloadStatus) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("Deployment.Namespace", desired.Namespace, "Deployment.Name", desired.Name)
 
-	// Get existing PVC
+	// Get existing Deployment
 	var current appsv1.Deployment
 	err := c.Get(ctx, types.NamespacedName{
 		Name:      desired.Name,
 		Namespace: desired.Namespace,
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
-		klog.Info("Creating new Deployment")
-		if err := c.Create(ctx, &desired); err != nil {
-			return ctrl.Result{}, fmt.Errorf("error creating Deployment \"%s\": %v", desired.Name, err)
+		if status.Recreation != nil {
+			// The old Deployment is gone, so the ReplicaSets it orphaned are relabeled for the new Deployment to adopt.
+			// The new Deployment then rolls its pods over to the new template the same way it would for any other update.
+			klog.Info("Adopting orphaned ReplicaSets into recreated Deployment")
+			status.Recreation.Phase = k8schianetv1.WorkloadRecreationAdopting
+			if err := adoptOrphans(ctx, c, &appsv1.ReplicaSetList{}, desired.Namespace, status.Recreation.Selector, desired.Spec.Selector.MatchLabels); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error adopting ReplicaSets orphaned by Deployment \"%s\": %v", desired.Name, err)
+			}
+		} else {
+			klog.Info("Creating new Deployment")
 		}
 	} else if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error getting existing Deployment \"%s\": %v", desired.Name, err)
 	} else {
-		// Need to handle a case where Deployment's spec.Selector.MatchLabels changed, since the field is immutable
-		if !reflect.DeepEqual(current.Spec.Selector.MatchLabels, desired.Spec.Selector.MatchLabels) {
-			klog.Info("Recreating Deployment for new Selector labels -- selector labels are immutable")
-
-			if err := c.Delete(ctx, &current); err != nil {
-				if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-				}
-				return ctrl.Result{}, fmt.Errorf("error deleting Deployment \"%s\": %v", current.Name, err)
-			}
-
-			// Wait for the deployment to be deleted
-			for {
-				var tmp appsv1.Deployment
-				err = c.Get(ctx, types.NamespacedName{
-					Namespace: current.Namespace,
-					Name:      current.Name,
-				}, &tmp)
-				if err != nil {
-					if client.IgnoreNotFound(err) == nil {
-						break
-					}
-					return ctrl.Result{}, fmt.Errorf("error waiting for Deployment to be deleted \"%s\": %v", desired.Name, err)
-				}
-				time.Sleep(2 * time.Second)
-			}
-
-			if err := c.Create(ctx, &desired); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error creating Deployment \"%s\": %v", desired.Name, err)
-			}
-
-			return ctrl.Result{}, nil // Exit reconciler here because we created the desired Deployment
-		}
-
-		// Deployment exists, so we need to update it if there are any changes.
-		// We'll make a copy of the current Deployment to make sure we only change mutable Deployment fields.
-		// Then we will compare the current and updated Deployments, and send an Update request if there was any diff.
-		updated := current
-
-		desiredAnnotations := CombineMaps(current.Annotations, desired.Annotations)
-		if !reflect.DeepEqual(current.Annotations, desiredAnnotations) {
-			updated.Annotations = desiredAnnotations
-		}
-
-		if !reflect.DeepEqual(current.Labels, desired.Labels) {
-			updated.Labels = desired.Labels
-		}
-
-		if !reflect.DeepEqual(current.Spec, desired.Spec) {
-			updated.Spec = desired.Spec
-		}
-
-		if !reflect.DeepEqual(current, updated) {
-			if err := c.Update(ctx, &updated); err != nil {
-				if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-				}
-				return ctrl.Result{}, fmt.Errorf("error updating Deployment \"%s\": %v", updated.Name, err)
-			}
+		if recreating, res, err := recreateForSelector(ctx, c, "Deployment", &current, current.Spec.Selector, desired.Spec.Selector, status); recreating {
+			return res, err
 		}
+		preserveRestartedAtAnnotation(current.Spec.Template, &desired.Spec.Template)
 	}
 
-	return ctrl.Result{}, nil
+	res, err := applyObject(ctx, c, "Deployment", &current, &desired)
+	if err == nil && res.IsZero() {
+		status.Recreation = nil
+	}
+	return res, err
 }
 
-// ReconcileStatefulset uses the controller-runtime client to determine if the statefulset resource needs to be created or updated
-func ReconcileStatefulset(ctx context.Context, c client.Client, desired appsv1.StatefulSet) (reconcile.Result, error) {
+// ReconcileStatefulset uses the controller-runtime client to determine if the statefulset resource needs to be applied.
+// A StatefulSet whose selector changed is recreated over several reconciles, which is recorded in the owning resource's workload status.
+// The reconcile should stop and requeue with the returned result while status.Recreation is set.
+func ReconcileStatefulset(ctx context.Context, c client.Client, desired appsv1.StatefulSet, status *k8schianetv1.WorkloadStatus) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("StatefulSet.Namespace", desired.Namespace, "StatefulSet.Name", desired.Name)
 
-	// Get existing PVC
+	// Get existing StatefulSet
 	var current appsv1.StatefulSet
 	err := c.Get(ctx, types.NamespacedName{
 		Name:      desired.Name,
 		Namespace: desired.Namespace,
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
-		klog.Info("Creating new StatefulSet")
-		if err := c.Create(ctx, &desired); err != nil {
-			return ctrl.Result{}, fmt.Errorf("error creating StatefulSet \"%s\": %v", desired.Name, err)
+		if status.Recreation != nil {
+			// The old StatefulSet is gone, so the pods it orphaned are relabeled for the new StatefulSet to adopt.
+			// Otherwise the new StatefulSet couldn't create its pods, since the orphans already have their names.
+			klog.Info("Adopting orphaned pods into recreated StatefulSet")
+			status.Recreation.Phase = k8schianetv1.WorkloadRecreationAdopting
+			if err := adoptOrphans(ctx, c, &corev1.PodList{}, desired.Namespace, status.Recreation.Selector, desired.Spec.Selector.MatchLabels); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error adopting pods orphaned by StatefulSet \"%s\": %v", desired.Name, err)
+			}
+		} else {
+			klog.Info("Creating new StatefulSet")
 		}
 	} else if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error getting existing StatefulSet \"%s\": %v", desired.Name, err)
 	} else {
-		// Need to handle a case where StatefulSet's spec.Selector.MatchLabels changed, since the field is immutable
-		if !reflect.DeepEqual(current.Spec.Selector.MatchLabels, desired.Spec.Selector.MatchLabels) {
-			klog.Info("Recreating StatefulSet for new Selector labels -- selector labels are immutable")
+		if recreating, res, err := recreateForSelector(ctx, c, "StatefulSet", &current, current.Spec.Selector, desired.Spec.Selector, status); recreating {
+			return res, err
+		}
 
-			if err := c.Delete(ctx, &current); err != nil {
-				if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-				}
-				return ctrl.Result{}, fmt.Errorf("error deleting StatefulSet \"%s\": %v", current.Name, err)
-			}
+		// Some StatefulSet spec fields are immutable, so they're applied as they are rather than as desired
+		desired.Spec.VolumeClaimTemplates = current.Spec.VolumeClaimTemplates
+		desired.Spec.ServiceName = current.Spec.ServiceName
+		desired.Spec.PodManagementPolicy = current.Spec.PodManagementPolicy
+		preserveRestartedAtAnnotation(current.Spec.Template, &desired.Spec.Template)
+	}
 
-			// Wait for the statefulset to be deleted
-			for {
-				var tmp appsv1.StatefulSet
-				err = c.Get(ctx, types.NamespacedName{
-					Namespace: current.Namespace,
-					Name:      current.Name,
-				}, &tmp)
-				if err != nil {
-					if client.IgnoreNotFound(err) == nil {
-						break
-					}
-					return ctrl.Result{}, fmt.Errorf("error waiting for StatefulSet to be deleted \"%s\": %v", desired.Name, err)
-				}
-				time.Sleep(2 * time.Second)
-			}
+	res, err := applyObject(ctx, c, "StatefulSet", &current, &desired)
+	if err == nil && res.IsZero() {
+		status.Recreation = nil
+	}
+	return res, err
+}
 
-			if err := c.Create(ctx, &desired); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error creating StatefulSet \"%s\": %v", desired.Name, err)
-			}
+// recreateForSelector steps through deleting an existing workload whose selector changed, since a selector can't be updated in place.
+// Each step requeues rather than waiting, and the recreation is recorded in status so it carries on across reconciles.
+// The workload is deleted with the objects it owns orphaned, so its pods keep running until the new workload adopts them.
+// Returns true while the existing workload is being recreated, along with the result and error the reconcile should return.
+func recreateForSelector(ctx context.Context, c client.Client, kind string, current client.Object, currentSelector, desiredSelector *metav1.LabelSelector, status *k8schianetv1.WorkloadStatus) (bool, reconcile.Result, error) {
+	klog := log.FromContext(ctx).WithValues(kind+".Namespace", current.GetNamespace(), kind+".Name", current.GetName())
 
-			return ctrl.Result{}, nil // Exit reconciler here because we created the desired StatefulSet
-		}
+	// Wait for a workload that's already being deleted to be gone
+	if current.GetDeletionTimestamp() != nil {
+		return true, ctrl.Result{RequeueAfter: 2 * time.Second}, nil
+	}
 
-		// StatefulSet exists, so we need to update it if there are any changes.
-		// We'll make a copy of the current StatefulSet to make sure we only change mutable StatefulSet fields.
-		// Then we will compare the current and updated StatefulSets, and send an Update request if there was any diff.
-		updated := current
+	if reflect.DeepEqual(currentSelector.MatchLabels, desiredSelector.MatchLabels) {
+		// Nothing to recreate, or the selector was changed back before the workload was deleted
+		status.Recreation = nil
+		return false, ctrl.Result{}, nil
+	}
 
-		desiredAnnotations := CombineMaps(current.Annotations, desired.Annotations)
-		if !reflect.DeepEqual(current.Annotations, desiredAnnotations) {
-			updated.Annotations = desiredAnnotations
+	if status.Recreation == nil {
+		// The recreation is recorded before anything is deleted, since the old selector is needed to find the orphans once the workload is gone
+		klog.Info(fmt.Sprintf("Recreating %s for new Selector labels -- selector labels are immutable", kind))
+		status.Recreation = &k8schianetv1.WorkloadRecreation{
+			Phase:     k8schianetv1.WorkloadRecreationDeleting,
+			Selector:  currentSelector.MatchLabels,
+			StartTime: metav1.Now(),
 		}
+		return true, ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+	}
 
-		if !reflect.DeepEqual(current.Labels, desired.Labels) {
-			updated.Labels = desired.Labels
+	if err := c.Delete(ctx, current, client.PropagationPolicy(metav1.DeletePropagationOrphan)); err != nil {
+		if errors.IsNotFound(err) || errors.IsConflict(err) {
+			return true, ctrl.Result{RequeueAfter: 1 * time.Second}, nil
 		}
+		return true, ctrl.Result{}, fmt.Errorf("error deleting %s \"%s\": %v", kind, current.GetName(), err)
+	}
+	metrics.RecordObjectAction(current, kind, metrics.ActionDelete)
 
-		if !reflect.DeepEqual(current.Spec.UpdateStrategy, desired.Spec.UpdateStrategy) {
-			updated.Spec.UpdateStrategy = desired.Spec.UpdateStrategy
-		}
+	return true, ctrl.Result{RequeueAfter: 2 * time.Second}, nil
+}
 
-		if !reflect.DeepEqual(current.Spec.Replicas, desired.Spec.Replicas) {
-			updated.Spec.Replicas = desired.Spec.Replicas
-		}
+// adoptOrphans relabels the objects an old workload orphaned when it was deleted, so the new workload's selector matches them and it adopts them.
+// Objects that have a controller already are left alone.
+func adoptOrphans(ctx context.Context, c client.Client, list client.ObjectList, namespace string, selector, labels map[string]string) error {
+	// An empty selector would match every object in the namespace
+	if len(selector) == 0 {
+		return nil
+	}
 
-		if !reflect.DeepEqual(current.Spec.Template, desired.Spec.Template) {
-			updated.Spec.Template = desired.Spec.Template
-		}
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels(selector)); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
 
-		if !reflect.DeepEqual(current, updated) {
-			if err := c.Update(ctx, &updated); err != nil {
-				if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-				}
-				return ctrl.Result{}, fmt.Errorf("error updating StatefulSet \"%s\": %v", desired.Name, err)
-			}
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || metav1.GetControllerOf(obj) != nil {
+			continue
+		}
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		obj.SetLabels(CombineMaps(obj.GetLabels(), labels))
+		if err := c.Patch(ctx, obj, patch); err != nil {
+			return err
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return nil
 }
 
-// ReconcilePersistentVolumeClaim uses the controller-runtime client to determine if the PVC resource needs to be created or updated
+// ReconcilePersistentVolumeClaim uses the controller-runtime client to determine if the PVC resource needs to be applied
 func ReconcilePersistentVolumeClaim(ctx context.Context, c client.Client, storage *k8schianetv1.StorageConfig, desired corev1.PersistentVolumeClaim) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("PersistentVolumeClaim.Namespace", desired.Namespace, "PersistentVolumeClaim.Name", desired.Name)
 	ensurePVCExists := ShouldMakeChiaRootVolumeClaim(storage)
 
+	// For safety reasons we never delete PVCs, however, chia-operator users should clean up their own storage if desired
+	if !ensurePVCExists {
+		return ctrl.Result{}, nil
+	}
+
 	// Get existing PVC
 	var current corev1.PersistentVolumeClaim
 	err := c.Get(ctx, types.NamespacedName{
@@ -266,41 +255,25 @@ func ReconcilePersistentVolumeClaim(ctx context.Context, c client.Client, storag
 		Namespace: desired.Namespace,
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
-		// PVC not found - create if it should exist, or return here if it shouldn't
-		if ensurePVCExists {
-			klog.Info("Creating new PersistentVolumeClaim")
-			if err := c.Create(ctx, &desired); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error creating PersistentVolumeClaim \"%s\": %v", desired.Name, err)
-			}
-		} else {
-			return ctrl.Result{}, nil
-		}
+		klog.Info("Creating new PersistentVolumeClaim")
 	} else if err != nil {
 		// Getting PVC failed, but it wasn't because it doesn't exist, can't continue
 		return ctrl.Result{}, fmt.Errorf("error getting existing PersistentVolumeClaim \"%s\": %v", desired.Name, err)
 	} else {
-		// PVC exists, so we need to update it if GeneratePersistentVolumes is enabled
-		// For safety reasons we never delete PVCs, however, chia-operator users should clean up their own storage if desired
-		if ensurePVCExists {
-			desiredAnnotations := CombineMaps(current.Annotations, desired.Annotations)
-			if !reflect.DeepEqual(current.Labels, desired.Labels) || !reflect.DeepEqual(current.Annotations, desiredAnnotations) || !reflect.DeepEqual(current.Spec.Resources.Requests, desired.Spec.Resources.Requests) {
-				current.Labels = desired.Labels
-				current.Annotations = desiredAnnotations
-				current.Spec.Resources.Requests = desired.Spec.Resources.Requests
-				if err := c.Update(ctx, &current); err != nil {
-					if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-						return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-					}
-					return ctrl.Result{}, fmt.Errorf("error updating PersistentVolumeClaim \"%s\": %v", desired.Name, err)
-				}
-			}
+		// Only the storage requests of an existing PVC's spec can be changed, so they're the only part of the spec that's applied.
+		// The rest of the spec was set when the PVC was created, or by Kubernetes, like the volumeName of a bound PVC.
+		desired.Spec = corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: desired.Spec.Resources.Requests,
+			},
 		}
+		return applyObjectAs(ctx, c, pvcFieldManager, "PersistentVolumeClaim", &current, &desired)
 	}
 
-	return ctrl.Result{}, nil
+	return applyObject(ctx, c, "PersistentVolumeClaim", &current, &desired)
 }
 
-// ReconcileConfigMap uses the controller-runtime client to determine if the ConfigMap resource needs to be created or updated
+// ReconcileConfigMap uses the controller-runtime client to determine if the ConfigMap resource needs to be applied
 func ReconcileConfigMap(ctx context.Context, c client.Client, desired corev1.ConfigMap) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("ConfigMap.Namespace", desired.Namespace, "ConfigMap.Name", desired.Name)
 
@@ -311,33 +284,36 @@ func ReconcileConfigMap(ctx context.Context, c client.Client, desired corev1.Con
 		Namespace: desired.Namespace,
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
-		// ConfigMap not found - create it
 		klog.Info("Creating new ConfigMap")
-		if err := c.Create(ctx, &desired); err != nil {
-			return ctrl.Result{}, fmt.Errorf("error creating ConfigMap \"%s\": %v", desired.Name, err)
-		}
 	} else if err != nil {
 		// Getting ConfigMap failed, but it wasn't because it doesn't exist, can't continue
 		return ctrl.Result{}, fmt.Errorf("error getting existing ConfigMap \"%s\": %v", desired.Name, err)
-	} else {
-		updated := current
+	}
+
+	return applyObject(ctx, c, "ConfigMap", &current, &desired)
+}
 
-		if !reflect.DeepEqual(current.Data, desired.Data) {
-			updated.Data = desired.Data
+// ReconcileSecret uses the controller-runtime client to determine if the Secret resource needs to be applied
+func ReconcileSecret(ctx context.Context, c client.Client, desired corev1.Secret) (reconcile.Result, error) {
+	klog := log.FromContext(ctx).WithValues("Secret.Namespace", desired.Namespace, "Secret.Name", desired.Name)
 
-			if err := c.Update(ctx, &updated); err != nil {
-				if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-				}
-				return ctrl.Result{}, fmt.Errorf("error updating ConfigMap \"%s\": %v", desired.Name, err)
-			}
-		}
+	// Get existing Secret
+	var current corev1.Secret
+	err := c.Get(ctx, types.NamespacedName{
+		Name:      desired.Name,
+		Namespace: desired.Namespace,
+	}, &current)
+	if err != nil && errors.IsNotFound(err) {
+		klog.Info("Creating new Secret")
+	} else if err != nil {
+		// Getting Secret failed, but it wasn't because it doesn't exist, can't continue
+		return ctrl.Result{}, fmt.Errorf("error getting existing Secret \"%s\": %v", desired.Name, err)
 	}
 
-	return ctrl.Result{}, nil
+	return applyObject(ctx, c, "Secret", &current, &desired)
 }
 
-// ReconcileIngress uses the controller-runtime client to determine if the Ingress resource needs to be created or updated
+// ReconcileIngress uses the controller-runtime client to determine if the Ingress resource needs to be applied or deleted
 func ReconcileIngress(ctx context.Context, c client.Client, ingress k8schianetv1.IngressConfig, desired networkingv1.Ingress) (reconcile.Result, error) {
 	klog := log.FromContext(ctx).WithValues("Ingress.Namespace", desired.Namespace, "Ingress.Name", desired.Name)
 
@@ -354,38 +330,174 @@ func ReconcileIngress(ctx context.Context, c client.Client, ingress k8schianetv1
 	}, &current)
 	if err != nil && errors.IsNotFound(err) {
 		// Ingress not found - create if it should exist, or return here if it shouldn't
-		if ensureIngressExists {
-			klog.Info("Creating new Ingress")
-			if err := c.Create(ctx, &desired); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error creating Ingress \"%s\": %v", desired.Name, err)
-			}
-		} else {
+		if !ensureIngressExists {
 			return ctrl.Result{}, nil
 		}
+		klog.Info("Creating new Ingress")
 	} else if err != nil {
 		// Getting Ingress failed, but it wasn't because it doesn't exist, can't do anything
 		return ctrl.Result{}, fmt.Errorf("error getting existing Ingress \"%s\": %v", desired.Name, err)
-	} else {
-		// Ingress exists, so we need to update it if there are any changes, or delete if it was disabled
-		if ensureIngressExists {
-			if !reflect.DeepEqual(current.Spec, desired.Spec) || !reflect.DeepEqual(current.Labels, desired.Labels) || !reflect.DeepEqual(current.Annotations, desired.Annotations) {
-				current.Labels = desired.Labels
-				current.Annotations = desired.Annotations
-				current.Spec = desired.Spec
-				if err := c.Update(ctx, &current); err != nil {
-					if strings.Contains(err.Error(), ObjectModifiedTryAgainError) {
-						return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
-					}
-					return ctrl.Result{}, fmt.Errorf("error updating Ingress \"%s\": %v", desired.Name, err)
+	} else if !ensureIngressExists {
+		klog.Info("Deleting Ingress because it was disabled")
+		if err := c.Delete(ctx, &current); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting Ingress \"%s\": %v", desired.Name, err)
+		}
+		metrics.RecordObjectAction(&current, "Ingress", metrics.ActionDelete)
+		return ctrl.Result{}, nil
+	}
+
+	return applyObject(ctx, c, "Ingress", &current, &desired)
+}
+
+// applyObject server-side applies the desired object with the operator's field manager, forcing ownership of the fields it sets.
+// Fields the desired object doesn't set are left to the API server's defaults and other field managers, such as autoscalers and service meshes,
+// so the operator no longer fights them over those fields. Fields the operator applied before and no longer sets are removed.
+// current is the existing object, which has no resourceVersion if it doesn't exist yet.
+func applyObject(ctx context.Context, c client.Client, kind string, current, desired client.Object) (reconcile.Result, error) {
+	return applyObjectAs(ctx, c, FieldManager, kind, current, desired)
+}
+
+// applyObjectAs server-side applies the desired object like applyObject, but with the given field manager
+func applyObjectAs(ctx context.Context, c client.Client, fieldManager, kind string, current, desired client.Object) (reconcile.Result, error) {
+	exists := current.GetResourceVersion() != ""
+	if exists {
+		// Objects last written before the operator used server-side apply have their fields owned by the legacy field managers.
+		// Those fields are handed to the operator's field manager, so fields the operator stops setting are still removed.
+		patch, err := csaupgrade.UpgradeManagedFieldsPatch(current, legacyFieldManagers, FieldManager)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error upgrading managed fields of %s \"%s\": %v", kind, current.GetName(), err)
+		}
+		if patch != nil {
+			if err := c.Patch(ctx, current, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+				if errors.IsConflict(err) {
+					return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
 				}
-			}
-		} else {
-			klog.Info("Deleting Ingress because it was disabled")
-			if err := c.Delete(ctx, &current); err != nil {
-				return ctrl.Result{}, fmt.Errorf("error deleting Ingress \"%s\": %v", desired.Name, err)
+				return ctrl.Result{}, fmt.Errorf("error upgrading managed fields of %s \"%s\": %v", kind, current.GetName(), err)
 			}
 		}
 	}
 
+	if err := prepareForApply(c.Scheme(), desired); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error applying %s \"%s\": %v", kind, desired.GetName(), err)
+	}
+	if err := c.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error applying %s \"%s\": %v", kind, desired.GetName(), err)
+	}
+
+	// The API server doesn't write an apply that changes nothing, so a new resourceVersion means the object was updated
+	if !exists {
+		metrics.RecordObjectAction(desired, kind, metrics.ActionCreate)
+	} else if desired.GetResourceVersion() != current.GetResourceVersion() {
+		metrics.RecordObjectAction(desired, kind, metrics.ActionUpdate)
+	}
+
 	return ctrl.Result{}, nil
 }
+
+// prepareForApply readies a desired object to be sent as a server-side apply configuration.
+// An apply configuration must include its apiVersion and kind, and must not include a resourceVersion or managedFields.
+func prepareForApply(scheme *runtime.Scheme, desired client.Object) error {
+	gvk, err := apiutil.GVKForObject(desired, scheme)
+	if err != nil {
+		return err
+	}
+	desired.GetObjectKind().SetGroupVersionKind(gvk)
+	desired.SetResourceVersion("")
+	desired.SetManagedFields(nil)
+	return nil
+}
+
+// preserveRestartedAtAnnotation copies the restartedAt annotation from the current pod template to the desired pod template.
+// Without this, reconciling a workload would strip the annotation and roll its Pods a second time after a restart.
+func preserveRestartedAtAnnotation(current corev1.PodTemplateSpec, desired *corev1.PodTemplateSpec) {
+	restartedAt, ok := current.Annotations[RestartedAtAnnotation]
+	if !ok {
+		return
+	}
+	desired.Annotations = CombineMaps(desired.Annotations, map[string]string{
+		RestartedAtAnnotation: restartedAt,
+	})
+}
+
+// ReconcilePodDisruptionBudget uses the controller-runtime client to determine if the PodDisruptionBudget resource needs to be applied or deleted
+func ReconcilePodDisruptionBudget(ctx context.Context, c client.Client, pdb *k8schianetv1.PodDisruptionBudgetConfig, desired policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	klog := log.FromContext(ctx).WithValues("PodDisruptionBudget.Namespace", desired.Namespace, "PodDisruptionBudget.Name", desired.Name)
+	ensurePDBExists := ShouldMakePodDisruptionBudget(pdb)
+
+	// Get existing PodDisruptionBudget
+	var current policyv1.PodDisruptionBudget
+	err := c.Get(ctx, types.NamespacedName{
+		Name:      desired.Name,
+		Namespace: desired.Namespace,
+	}, &current)
+	if err != nil && errors.IsNotFound(err) {
+		// PodDisruptionBudget not found - create if it should exist, or return here if it shouldn't
+		if !ensurePDBExists {
+			return ctrl.Result{}, nil
+		}
+		klog.Info("Creating new PodDisruptionBudget")
+	} else if err != nil {
+		// Getting PodDisruptionBudget failed, but it wasn't because it doesn't exist, can't do anything
+		return ctrl.Result{}, fmt.Errorf("error getting existing PodDisruptionBudget \"%s\": %v", desired.Name, err)
+	} else if !ensurePDBExists {
+		klog.Info("Deleting PodDisruptionBudget because it was disabled")
+		if err := c.Delete(ctx, &current); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting PodDisruptionBudget \"%s\": %v", desired.Name, err)
+		}
+		metrics.RecordObjectAction(&current, "PodDisruptionBudget", metrics.ActionDelete)
+		return ctrl.Result{}, nil
+	}
+
+	return applyObject(ctx, c, "PodDisruptionBudget", &current, &desired)
+}
+
+// ReconcileHorizontalPodAutoscaler uses the controller-runtime client to determine if the HorizontalPodAutoscaler resource needs to be applied or deleted
+func ReconcileHorizontalPodAutoscaler(ctx context.Context, c client.Client, autoscaling *k8schianetv1.AutoscalingConfig, desired autoscalingv2.HorizontalPodAutoscaler) (reconcile.Result, error) {
+	klog := log.FromContext(ctx).WithValues("HorizontalPodAutoscaler.Namespace", desired.Namespace, "HorizontalPodAutoscaler.Name", desired.Name)
+	ensureHPAExists := ShouldMakeHorizontalPodAutoscaler(autoscaling)
+
+	// Get existing HorizontalPodAutoscaler
+	var current autoscalingv2.HorizontalPodAutoscaler
+	err := c.Get(ctx, types.NamespacedName{
+		Name:      desired.Name,
+		Namespace: desired.Namespace,
+	}, &current)
+	if err != nil && errors.IsNotFound(err) {
+		// HorizontalPodAutoscaler not found - create if it should exist, or return here if it shouldn't
+		if !ensureHPAExists {
+			return ctrl.Result{}, nil
+		}
+		klog.Info("Creating new HorizontalPodAutoscaler")
+	} else if err != nil {
+		// Getting HorizontalPodAutoscaler failed, but it wasn't because it doesn't exist, can't do anything
+		return ctrl.Result{}, fmt.Errorf("error getting existing HorizontalPodAutoscaler \"%s\": %v", desired.Name, err)
+	} else if !ensureHPAExists {
+		klog.Info("Deleting HorizontalPodAutoscaler because it was disabled")
+		if err := c.Delete(ctx, &current); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting HorizontalPodAutoscaler \"%s\": %v", desired.Name, err)
+		}
+		metrics.RecordObjectAction(&current, "HorizontalPodAutoscaler", metrics.ActionDelete)
+		return ctrl.Result{}, nil
+	}
+
+	return applyObject(ctx, c, "HorizontalPodAutoscaler", &current, &desired)
+}
+
+// DeleteObject uses the controller-runtime client to delete an object the operator generated, if it exists.
+// It's used for workloads that are no longer generated because of a change to the resource that owns them.
+func DeleteObject(ctx context.Context, c client.Client, kind string, obj client.Object) error {
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting existing %s \"%s\": %v", kind, obj.GetName(), err)
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Deleting %s because it's no longer needed", kind), kind+".Namespace", obj.GetNamespace(), kind+".Name", obj.GetName())
+	if err := c.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error deleting %s \"%s\": %v", kind, obj.GetName(), err)
+	}
+	metrics.RecordObjectAction(obj, kind, metrics.ActionDelete)
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestPrepareForApply(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	desired := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "node",
+			ResourceVersion: "10",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "manager"}},
+		},
+	}
+	require.NoError(t, prepareForApply(scheme, &desired))
+	assert.Equal(t, "apps/v1", desired.APIVersion)
+	assert.Equal(t, "Deployment", desired.Kind)
+	assert.Empty(t, desired.ResourceVersion)
+	assert.Nil(t, desired.ManagedFields)
+
+	require.Error(t, prepareForApply(runtime.NewScheme(), &desired))
+}
+
+func TestRecreateForSelector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	current := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "old"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&current).Build()
+	ctx := context.Background()
+	desiredSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "new"}}
+	var status k8schianetv1.WorkloadStatus
+
+	// The recreation is recorded before anything is deleted
+	recreating, res, err := recreateForSelector(ctx, c, "StatefulSet", &current, current.Spec.Selector, desiredSelector, &status)
+	require.NoError(t, err)
+	assert.True(t, recreating)
+	assert.NotZero(t, res.RequeueAfter)
+	require.NotNil(t, status.Recreation)
+	assert.Equal(t, k8schianetv1.WorkloadRecreationDeleting, status.Recreation.Phase)
+	assert.Equal(t, map[string]string{"app": "old"}, status.Recreation.Selector)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&current), &appsv1.StatefulSet{}))
+
+	// The next reconcile deletes the workload, and requeues rather than waiting for it to be gone
+	recreating, res, err = recreateForSelector(ctx, c, "StatefulSet", &current, current.Spec.Selector, desiredSelector, &status)
+	require.NoError(t, err)
+	assert.True(t, recreating)
+	assert.NotZero(t, res.RequeueAfter)
+	err = c.Get(ctx, client.ObjectKeyFromObject(&current), &appsv1.StatefulSet{})
+	assert.True(t, errors.IsNotFound(err))
+
+	// A selector that was changed back ends the recreation
+	recreating, _, err = recreateForSelector(ctx, c, "StatefulSet", &current, current.Spec.Selector, current.Spec.Selector, &status)
+	require.NoError(t, err)
+	assert.False(t, recreating)
+	assert.Nil(t, status.Recreation)
+}
+
+func TestAdoptOrphans(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	orphan := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-0", Namespace: "default", Labels: map[string]string{"app": "old", "extra": "kept"}},
+	}
+	owned := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-0",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "old"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "other", UID: "uid", Controller: ptr.To(true)},
+			},
+		},
+	}
+	unrelated := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "farmer", Namespace: "default", Labels: map[string]string{"app": "farmer"}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&orphan, &owned, &unrelated).Build()
+	ctx := context.Background()
+
+	require.NoError(t, adoptOrphans(ctx, c, &corev1.PodList{}, "default", map[string]string{"app": "old"}, map[string]string{"app": "new", "chia": "node"}))
+
+	var pod corev1.Pod
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&orphan), &pod))
+	assert.Equal(t, map[string]string{"app": "new", "chia": "node", "extra": "kept"}, pod.Labels)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&owned), &pod))
+	assert.Equal(t, map[string]string{"app": "old"}, pod.Labels)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&unrelated), &pod))
+	assert.Equal(t, map[string]string{"app": "farmer"}, pod.Labels)
+
+	// An empty selector adopts nothing
+	require.NoError(t, adoptOrphans(ctx, c, &corev1.PodList{}, "default", nil, map[string]string{"app": "new"}))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(&unrelated), &pod))
+	assert.Equal(t, map[string]string{"app": "farmer"}, pod.Labels)
+}
+
+func TestReconcilePodDisruptionBudget_Disabled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	desired := AssemblePodDisruptionBudget(AssemblePodDisruptionBudgetInputs{Name: "node", Namespace: "default"})
+	ctx := context.Background()
+
+	// A disabled PodDisruptionBudget that doesn't exist isn't created
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	_, err := ReconcilePodDisruptionBudget(ctx, c, nil, desired)
+	require.NoError(t, err)
+	err = c.Get(ctx, client.ObjectKeyFromObject(&desired), &policyv1.PodDisruptionBudget{})
+	assert.True(t, errors.IsNotFound(err))
+
+	// A PodDisruptionBudget that exists is deleted once it's disabled
+	existing := desired.DeepCopy()
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	_, err = ReconcilePodDisruptionBudget(ctx, c, &k8schianetv1.PodDisruptionBudgetConfig{Enabled: ptr.To(false)}, desired)
+	require.NoError(t, err)
+	err = c.Get(ctx, client.ObjectKeyFromObject(&desired), &policyv1.PodDisruptionBudget{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestReconcilePersistentVolumeClaim_Existing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	current := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: ptr.To("default"),
+			VolumeName:       "pvc-1234",
+			VolumeMode:       ptr.To(corev1.PersistentVolumeFilesystem),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+	desired := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default", Labels: map[string]string{"app": "node"}},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: ptr.To(""),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+			},
+		},
+	}
+	storage := &k8schianetv1.StorageConfig{
+		ChiaRoot: &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{GenerateVolumeClaims: true},
+		},
+	}
+
+	// The fake client doesn't support server-side apply, so the apply is captured instead
+	var applied *corev1.PersistentVolumeClaim
+	var patchOpts client.PatchOptions
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(current.DeepCopy()).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			applied = obj.(*corev1.PersistentVolumeClaim).DeepCopy()
+			patchOpts.ApplyOptions(opts)
+			return nil
+		},
+	}).Build()
+
+	_, err := ReconcilePersistentVolumeClaim(context.Background(), c, storage, desired)
+	require.NoError(t, err)
+	require.NotNil(t, applied)
+
+	// Only the storage requests and metadata are applied, so the operator doesn't take ownership of fields set at creation or by Kubernetes
+	assert.Equal(t, corev1.PersistentVolumeClaimSpec{
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+		},
+	}, applied.Spec)
+	assert.Equal(t, map[string]string{"app": "node"}, applied.Labels)
+	assert.Equal(t, pvcFieldManager, patchOpts.FieldManager)
+}
+
+func TestReconcileHorizontalPodAutoscaler_Disabled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	desired := AssembleHorizontalPodAutoscaler(AssembleHorizontalPodAutoscalerInputs{Name: "seeder", Namespace: "default", DeploymentName: "seeder", MaxReplicas: 3})
+	ctx := context.Background()
+
+	// A disabled HorizontalPodAutoscaler that doesn't exist isn't created
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	_, err := ReconcileHorizontalPodAutoscaler(ctx, c, nil, desired)
+	require.NoError(t, err)
+	err = c.Get(ctx, client.ObjectKeyFromObject(&desired), &autoscalingv2.HorizontalPodAutoscaler{})
+	assert.True(t, errors.IsNotFound(err))
+
+	// A HorizontalPodAutoscaler that exists is deleted once it's disabled
+	existing := desired.DeepCopy()
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	_, err = ReconcileHorizontalPodAutoscaler(ctx, c, &k8schianetv1.AutoscalingConfig{Enabled: ptr.To(false), MaxReplicas: 3}, desired)
+	require.NoError(t, err)
+	err = c.Get(ctx, client.ObjectKeyFromObject(&desired), &autoscalingv2.HorizontalPodAutoscaler{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestDeleteObject(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	deploy := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "fileserver", Namespace: "default"}}
+	ctx := context.Background()
+
+	// Deleting an object that doesn't exist is a no-op
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	require.NoError(t, DeleteObject(ctx, c, "Deployment", deploy.DeepCopy()))
+
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(deploy.DeepCopy()).Build()
+	require.NoError(t, DeleteObject(ctx, c, "Deployment", deploy.DeepCopy()))
+	err := c.Get(ctx, client.ObjectKeyFromObject(&deploy), &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err))
+}
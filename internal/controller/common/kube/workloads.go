@@ -0,0 +1,207 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ChiaContainerName is the name of the chia container in every pod the operator runs
+const ChiaContainerName = "chia"
+
+// WorkloadRollout is the observed rollout of a Deployment or StatefulSet
+type WorkloadRollout struct {
+	// Kind is the kind of workload, Deployment or StatefulSet
+	Kind string
+
+	// Status is the rollout status reported on the owning resource
+	Status k8schianetv1.WorkloadStatus
+
+	// Complete is true when the workload controller has observed the latest spec and all replicas are updated and available
+	Complete bool
+
+	// Stalled describes why the rollout can't make progress on its own, if it can't
+	Stalled string
+}
+
+// Ready returns true if the rollout is complete and none of the workload's pods are failing
+func (r WorkloadRollout) Ready() bool {
+	return r.Complete && len(r.Status.FailingPods) == 0
+}
+
+// GetDeploymentRollout reads the rollout of a Deployment and its failing pods.
+// A Deployment that isn't in the cache yet is reported as an incomplete rollout.
+func GetDeploymentRollout(ctx context.Context, c client.Client, name types.NamespacedName) (WorkloadRollout, error) {
+	var deploy appsv1.Deployment
+	err := c.Get(ctx, name, &deploy)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return WorkloadRollout{Kind: "Deployment"}, nil
+		}
+		return WorkloadRollout{}, fmt.Errorf("error getting Deployment \"%s\": %v", name.Name, err)
+	}
+
+	pods, err := ListWorkloadPods(ctx, c, deploy.Namespace, deploy.Spec.Selector)
+	if err != nil {
+		return WorkloadRollout{}, err
+	}
+
+	return deploymentRollout(deploy, pods), nil
+}
+
+// GetStatefulSetRollout reads the rollout of a StatefulSet and its failing pods.
+// A StatefulSet that isn't in the cache yet is reported as an incomplete rollout.
+func GetStatefulSetRollout(ctx context.Context, c client.Client, name types.NamespacedName) (WorkloadRollout, error) {
+	var stateful appsv1.StatefulSet
+	err := c.Get(ctx, name, &stateful)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return WorkloadRollout{Kind: "StatefulSet"}, nil
+		}
+		return WorkloadRollout{}, fmt.Errorf("error getting StatefulSet \"%s\": %v", name.Name, err)
+	}
+
+	pods, err := ListWorkloadPods(ctx, c, stateful.Namespace, stateful.Spec.Selector)
+	if err != nil {
+		return WorkloadRollout{}, err
+	}
+
+	return statefulSetRollout(stateful, pods), nil
+}
+
+// ListWorkloadPods lists the pods matching a workload's selector
+func ListWorkloadPods(ctx context.Context, c client.Client, namespace string, selector *metav1.LabelSelector) ([]corev1.Pod, error) {
+	if selector == nil {
+		return nil, nil
+	}
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing workload selector: %v", err)
+	}
+
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		return nil, fmt.Errorf("error listing workload pods: %v", err)
+	}
+	return pods.Items, nil
+}
+
+// RunningPods returns the pods that are running and have an IP address, sorted by name
+func RunningPods(pods []corev1.Pod) []corev1.Pod {
+	var running []corev1.Pod
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning && pod.Status.PodIP != "" {
+			running = append(running, pod)
+		}
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].Name < running[j].Name
+	})
+	return running
+}
+
+// deploymentRollout computes a Deployment's rollout from its status and pods
+func deploymentRollout(deploy appsv1.Deployment, pods []corev1.Pod) WorkloadRollout {
+	rollout := WorkloadRollout{
+		Kind:     "Deployment",
+		Status:   workloadStatusFromPods(pods),
+		Complete: DeploymentRolledOut(deploy),
+	}
+	rollout.Status.Replicas = deploy.Status.Replicas
+	rollout.Status.ReadyReplicas = deploy.Status.ReadyReplicas
+	rollout.Status.AvailableReplicas = deploy.Status.AvailableReplicas
+	rollout.Status.UpdatedReplicas = deploy.Status.UpdatedReplicas
+
+	for _, condition := range deploy.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded" {
+			rollout.Stalled = condition.Message
+		}
+	}
+
+	return rollout
+}
+
+// statefulSetRollout computes a StatefulSet's rollout from its status and pods
+func statefulSetRollout(stateful appsv1.StatefulSet, pods []corev1.Pod) WorkloadRollout {
+	rollout := WorkloadRollout{
+		Kind:     "StatefulSet",
+		Status:   workloadStatusFromPods(pods),
+		Complete: StatefulSetRolledOut(stateful),
+	}
+	rollout.Status.Replicas = stateful.Status.Replicas
+	rollout.Status.ReadyReplicas = stateful.Status.ReadyReplicas
+	rollout.Status.AvailableReplicas = stateful.Status.AvailableReplicas
+	rollout.Status.UpdatedReplicas = stateful.Status.UpdatedReplicas
+
+	return rollout
+}
+
+// workloadStatusFromPods collects the running chia images and failing pods of a workload
+func workloadStatusFromPods(pods []corev1.Pod) k8schianetv1.WorkloadStatus {
+	var status k8schianetv1.WorkloadStatus
+	images := make(map[string]bool)
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.Name == ChiaContainerName && container.State.Running != nil && !images[container.Image] {
+				images[container.Image] = true
+				status.Images = append(status.Images, container.Image)
+			}
+		}
+		if failing := podFailure(pod); failing != nil {
+			status.FailingPods = append(status.FailingPods, *failing)
+		}
+	}
+
+	sort.Strings(status.Images)
+	sort.Slice(status.FailingPods, func(i, j int) bool {
+		return status.FailingPods[i].Name < status.FailingPods[j].Name
+	})
+	return status
+}
+
+// podFailure returns why a pod can't start or keep running, or nil if it isn't failing
+func podFailure(pod corev1.Pod) *k8schianetv1.FailingPod {
+	if pod.Status.Phase == corev1.PodFailed {
+		reason := pod.Status.Reason
+		if reason == "" {
+			reason = "Failed"
+		}
+		return &k8schianetv1.FailingPod{Name: pod.Name, Reason: reason, Message: pod.Status.Message}
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable {
+			return &k8schianetv1.FailingPod{Name: pod.Name, Reason: condition.Reason, Message: condition.Message}
+		}
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, container := range statuses {
+		waiting := container.State.Waiting
+		if waiting == nil || waiting.Reason == "" || waiting.Reason == "ContainerCreating" || waiting.Reason == "PodInitializing" {
+			continue
+		}
+		message := fmt.Sprintf("container %s: %s", container.Name, waiting.Reason)
+		if waiting.Message != "" {
+			message = fmt.Sprintf("container %s: %s", container.Name, waiting.Message)
+		}
+		return &k8schianetv1.FailingPod{Name: pod.Name, Reason: waiting.Reason, Message: message}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runningPod(name, image string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  ChiaContainerName,
+					Image: image,
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+}
+
+func TestDeploymentRollout(t *testing.T) {
+	replicas := int32(2)
+	deploy := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  2,
+		},
+	}
+	pods := []corev1.Pod{
+		runningPod("a", "ghcr.io/chia-network/chia:2.5.0"),
+		runningPod("b", "ghcr.io/chia-network/chia:2.5.0"),
+	}
+
+	rollout := deploymentRollout(deploy, pods)
+	assert.Equal(t, "Deployment", rollout.Kind)
+	assert.True(t, rollout.Complete)
+	assert.True(t, rollout.Ready())
+	assert.Empty(t, rollout.Stalled)
+	assert.Equal(t, k8schianetv1.WorkloadStatus{
+		Replicas:          2,
+		ReadyReplicas:     2,
+		AvailableReplicas: 2,
+		UpdatedReplicas:   2,
+		Images:            []string{"ghcr.io/chia-network/chia:2.5.0"},
+	}, rollout.Status)
+
+	// Rolling out a new image
+	deploy.Status.UpdatedReplicas = 1
+	pods[1] = runningPod("b", "ghcr.io/chia-network/chia:2.5.1")
+	rollout = deploymentRollout(deploy, pods)
+	assert.False(t, rollout.Complete)
+	assert.Equal(t, []string{"ghcr.io/chia-network/chia:2.5.0", "ghcr.io/chia-network/chia:2.5.1"}, rollout.Status.Images)
+
+	// Stalled rollout
+	deploy.Status.Conditions = []appsv1.DeploymentCondition{
+		{
+			Type:    appsv1.DeploymentProgressing,
+			Status:  corev1.ConditionFalse,
+			Reason:  "ProgressDeadlineExceeded",
+			Message: "ReplicaSet has timed out progressing.",
+		},
+	}
+	rollout = deploymentRollout(deploy, pods)
+	assert.Equal(t, "ReplicaSet has timed out progressing.", rollout.Stalled)
+}
+
+func TestStatefulSetRollout(t *testing.T) {
+	stateful := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			Replicas:           1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+			UpdatedReplicas:    1,
+			CurrentRevision:    "rev-1",
+			UpdateRevision:     "rev-1",
+		},
+	}
+	pod := runningPod("node-0", "ghcr.io/chia-network/chia:2.5.0")
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s restarting failed container"},
+	}
+
+	rollout := statefulSetRollout(stateful, []corev1.Pod{pod})
+	assert.Equal(t, "StatefulSet", rollout.Kind)
+	assert.True(t, rollout.Complete)
+	assert.False(t, rollout.Ready())
+	assert.Empty(t, rollout.Status.Images)
+	require.Len(t, rollout.Status.FailingPods, 1)
+	assert.Equal(t, "node-0", rollout.Status.FailingPods[0].Name)
+	assert.Equal(t, "CrashLoopBackOff", rollout.Status.FailingPods[0].Reason)
+}
+
+func TestPodFailure(t *testing.T) {
+	testCases := map[string]struct {
+		pod    corev1.Pod
+		reason string
+	}{
+		"running": {
+			pod: runningPod("pod", "chia"),
+		},
+		"creating": {
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: ChiaContainerName, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+					},
+				},
+			},
+		},
+		"failed": {
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"},
+			},
+			reason: "Evicted",
+		},
+		"unschedulable": {
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					Conditions: []corev1.PodCondition{
+						{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+					},
+				},
+			},
+			reason: corev1.PodReasonUnschedulable,
+		},
+		"image pull": {
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{Name: "init", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+					},
+				},
+			},
+			reason: "ImagePullBackOff",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			failing := podFailure(tc.pod)
+			if tc.reason == "" {
+				assert.Nil(t, failing)
+				return
+			}
+			require.NotNil(t, failing)
+			assert.Equal(t, tc.reason, failing.Reason)
+		})
+	}
+}
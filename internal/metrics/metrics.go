@@ -1,120 +1,275 @@
 package metrics
 
 import (
+	"context"
+	"strconv"
+	"time"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
-var (
-	// ChiaCAs is a gauge metric that keeps a running total of deployed ChiaCAs
-	ChiaCAs = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiaca_total",
-			Help: "Number of ChiaCA objects controlled by this operator",
-		},
-	)
+// Actions recorded in the ObjectActions metric
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
 
-	// ChiaCertificates is a gauge metric that keeps a running total of deployed ChiaCertificates
-	ChiaCertificates = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiacertificates_total",
-			Help: "Number of ChiaCertificates objects controlled by this operator",
-		},
-	)
+// collectTimeout bounds how long a scrape waits to list resources from the cache
+const collectTimeout = 10 * time.Second
 
-	// ChiaCrawlers is a gauge metric that keeps a running total of deployed ChiaCrawlers
-	ChiaCrawlers = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiacrawler_total",
-			Help: "Number of ChiaCrawlers objects controlled by this operator",
-		},
-	)
+// resourceLabels are the labels of the gauge metrics that count the resources controlled by this operator
+var resourceLabels = []string{"namespace", "ready"}
 
-	// ChiaDataLayers is a gauge metric that keeps a running total of deployed ChiaDataLayers
-	ChiaDataLayers = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiadatalayer_total",
-			Help: "Number of ChiaDataLayers objects controlled by this operator",
-		},
-	)
+// resourceGauge is a gauge metric that counts the resources of a kind controlled by this operator
+type resourceGauge struct {
+	desc    *prometheus.Desc
+	newList func() client.ObjectList
+}
 
-	// ChiaFarmers is a gauge metric that keeps a running total of deployed ChiaFarmers
-	ChiaFarmers = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiafarmer_total",
-			Help: "Number of ChiaFarmer objects controlled by this operator",
-		},
-	)
+func newResourceGauge(name, help string, newList func() client.ObjectList) resourceGauge {
+	return resourceGauge{
+		desc:    prometheus.NewDesc(name, help, resourceLabels, nil),
+		newList: newList,
+	}
+}
 
-	// ChiaHarvesters is a gauge metric that keeps a running total of deployed ChiaHarvesters
-	ChiaHarvesters = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiaharvester_total",
-			Help: "Number of ChiaHarvester objects controlled by this operator",
-		},
-	)
+var (
+	// resourceGauges are the gauge metrics that keep a running total of the deployed resources of each kind
+	resourceGauges = []resourceGauge{
+		newResourceGauge("chia_operator_chiaca_total", "Number of ChiaCA objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaCAList{} }),
+		newResourceGauge("chia_operator_chiacertificates_total", "Number of ChiaCertificates objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaCertificatesList{} }),
+		newResourceGauge("chia_operator_chiacrawler_total", "Number of ChiaCrawlers objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaCrawlerList{} }),
+		newResourceGauge("chia_operator_chiadatalayer_total", "Number of ChiaDataLayers objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaDataLayerList{} }),
+		newResourceGauge("chia_operator_chiafarmer_total", "Number of ChiaFarmer objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaFarmerList{} }),
+		newResourceGauge("chia_operator_chiaharvester_total", "Number of ChiaHarvester objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaHarvesterList{} }),
+		newResourceGauge("chia_operator_chiaintroducer_total", "Number of ChiaIntroducer objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaIntroducerList{} }),
+		newResourceGauge("chia_operator_chiakey_total", "Number of ChiaKey objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaKeyList{} }),
+		newResourceGauge("chia_operator_chianode_total", "Number of ChiaNode objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaNodeList{} }),
+		newResourceGauge("chia_operator_chianetwork_total", "Number of ChiaNetworks objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaNetworkList{} }),
+		newResourceGauge("chia_operator_chiaseeder_total", "Number of ChiaSeeder objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaSeederList{} }),
+		newResourceGauge("chia_operator_chiatimelord_total", "Number of ChiaTimelord objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaTimelordList{} }),
+		newResourceGauge("chia_operator_chiawallet_total", "Number of ChiaWallet objects controlled by this operator",
+			func() client.ObjectList { return &k8schianetv1.ChiaWalletList{} }),
+	}
 
-	// ChiaIntroducers is a gauge metric that keeps a running total of deployed ChiaIntroducers
-	ChiaIntroducers = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiaintroducer_total",
-			Help: "Number of ChiaIntroducer objects controlled by this operator",
+	// ReconcileDuration is a histogram metric of how long reconciles take, by the kind of resource reconciled
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "chia_operator_reconcile_duration_seconds",
+			Help:    "Time taken to reconcile a resource, by kind",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
 		},
+		[]string{"kind"},
 	)
 
-	// ChiaNodes is a gauge metric that keeps a running total of deployed ChiaNodes
-	ChiaNodes = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chianode_total",
-			Help: "Number of ChiaNode objects controlled by this operator",
+	// ReconcileErrors is a counter metric of failed reconciles, by the kind of resource reconciled and the step that failed
+	ReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chia_operator_reconcile_errors_total",
+			Help: "Number of reconciles that failed, by kind and the step of the reconcile that failed",
 		},
+		[]string{"kind", "step"},
 	)
 
-	// ChiaNetworks is a gauge metric that keeps a running total of deployed ChiaNetworks
-	ChiaNetworks = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chianetwork_total",
-			Help: "Number of ChiaNetworks objects controlled by this operator",
+	// ObjectActions is a counter metric of the create, update and delete requests the operator makes for the objects it manages.
+	// An update count that keeps climbing while the resource isn't changing points to a hot loop.
+	ObjectActions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chia_operator_object_actions_total",
+			Help: "Number of create, update and delete requests made for objects managed by this operator, by the kind of the object's owner, the object's kind, and the action",
 		},
+		[]string{"owner_kind", "kind", "action"},
 	)
 
-	// ChiaSeeders is a gauge metric that keeps a running total of deployed ChiaSeeders
-	ChiaSeeders = prometheus.NewGauge(
+	// CrawlerPeers is a gauge metric that reports the peer counts of ChiaCrawlers and ChiaSeeders, if crawler peer metrics are enabled
+	CrawlerPeers = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
-			Name: "chia_operator_chiaseeder_total",
-			Help: "Number of ChiaSeeder objects controlled by this operator",
+			Name: "chia_operator_crawler_peers",
+			Help: "Number of peers found by a ChiaCrawler or ChiaSeeder, by type of count",
 		},
+		[]string{"kind", "namespace", "name", "type"},
 	)
 
-	// ChiaTimelords is a gauge metric that keeps a running total of deployed ChiaTimelords
-	ChiaTimelords = prometheus.NewGauge(
+	// CrawlerPeerVersions is a gauge metric that reports the Chia versions of the peers found by ChiaCrawlers and ChiaSeeders, if crawler peer metrics are enabled
+	CrawlerPeerVersions = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
-			Name: "chia_operator_chiatimelord_total",
-			Help: "Number of ChiaTimelord objects controlled by this operator",
-		},
-	)
-
-	// ChiaWallets is a gauge metric that keeps a running total of deployed ChiaWallets
-	ChiaWallets = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "chia_operator_chiawallet_total",
-			Help: "Number of ChiaWallet objects controlled by this operator",
+			Name: "chia_operator_crawler_peer_versions",
+			Help: "Number of peers found by a ChiaCrawler or ChiaSeeder running each Chia version",
 		},
+		[]string{"kind", "namespace", "name", "version"},
 	)
 )
 
 func init() {
 	metrics.Registry.MustRegister(
-		ChiaCAs,
-		ChiaCertificates,
-		ChiaCrawlers,
-		ChiaDataLayers,
-		ChiaFarmers,
-		ChiaHarvesters,
-		ChiaIntroducers,
-		ChiaNodes,
-		ChiaNetworks,
-		ChiaTimelords,
-		ChiaWallets,
+		ReconcileDuration,
+		ReconcileErrors,
+		ObjectActions,
+		CrawlerPeers,
+		CrawlerPeerVersions,
 	)
 }
+
+// ResourceCollector is a Prometheus collector that counts the resources controlled by this operator by namespace and readiness.
+// The counts are computed from the manager's informer cache on each scrape, so they're correct after a restart and with any number of concurrent reconciles.
+type ResourceCollector struct {
+	reader client.Reader
+}
+
+// NewResourceCollector returns a ResourceCollector that lists resources with reader, which should be the manager's cache
+func NewResourceCollector(reader client.Reader) *ResourceCollector {
+	return &ResourceCollector{reader: reader}
+}
+
+// RegisterResourceCollector registers a ResourceCollector with the controller-runtime metrics registry
+func RegisterResourceCollector(reader client.Reader) error {
+	return metrics.Registry.Register(NewResourceCollector(reader))
+}
+
+// Describe implements prometheus.Collector
+func (c *ResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, gauge := range resourceGauges {
+		ch <- gauge.desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+// A kind that can't be listed is left out of the scrape, rather than failing the whole scrape.
+func (c *ResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	for _, gauge := range resourceGauges {
+		list := gauge.newList()
+		if err := c.reader.List(ctx, list); err != nil {
+			log.Log.WithName("metrics").Error(err, "unable to list resources for metrics", "metric", gauge.desc.String())
+			continue
+		}
+		counts, err := countResources(list)
+		if err != nil {
+			log.Log.WithName("metrics").Error(err, "unable to count resources for metrics", "metric", gauge.desc.String())
+			continue
+		}
+		for key, count := range counts {
+			ch <- prometheus.MustNewConstMetric(gauge.desc, prometheus.GaugeValue, count, key.namespace, strconv.FormatBool(key.ready))
+		}
+	}
+}
+
+// resourceKey is the set of label values a resource is counted under
+type resourceKey struct {
+	namespace string
+	ready     bool
+}
+
+// countResources counts the resources in a list by namespace and readiness
+func countResources(list client.ObjectList) (map[resourceKey]float64, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[resourceKey]float64)
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		counts[resourceKey{namespace: obj.GetNamespace(), ready: isReady(item)}]++
+	}
+	return counts, nil
+}
+
+// isReady returns the Ready field of a resource's status
+func isReady(obj runtime.Object) bool {
+	switch o := obj.(type) {
+	case *k8schianetv1.ChiaCA:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaCertificates:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaCrawler:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaDataLayer:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaFarmer:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaHarvester:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaIntroducer:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaKey:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaNode:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaNetwork:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaSeeder:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaTimelord:
+		return o.Status.Ready
+	case *k8schianetv1.ChiaWallet:
+		return o.Status.Ready
+	}
+	return false
+}
+
+// ObserveReconcileDuration records the duration of a reconcile that began at start.
+// Meant to be deferred at the beginning of a Reconcile function, so start is evaluated when the reconcile begins.
+func ObserveReconcileDuration(kind string, start time.Time) {
+	ReconcileDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+}
+
+// RecordReconcileError counts a failed reconcile of a resource of the given kind, at the given step, such as "service" or "deployment"
+func RecordReconcileError(kind, step string) {
+	ReconcileErrors.WithLabelValues(kind, step).Inc()
+}
+
+// RecordObjectAction counts a create, update or delete request for an object the operator manages.
+// kind is the object's kind, since typed objects don't carry it. The owner kind is read from the object's controller reference.
+func RecordObjectAction(obj metav1.Object, kind, action string) {
+	ownerKind := ""
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		ownerKind = owner.Kind
+	}
+	ObjectActions.WithLabelValues(ownerKind, kind, action).Inc()
+}
+
+// SetCrawlerPeers sets the peer metrics of a ChiaCrawler or ChiaSeeder, replacing the versions it previously reported
+func SetCrawlerPeers(kind, namespace, name string, peers k8schianetv1.CrawlerPeerStatus) {
+	CrawlerPeers.WithLabelValues(kind, namespace, name, "total").Set(float64(peers.Total))
+	CrawlerPeers.WithLabelValues(kind, namespace, name, "reliable").Set(float64(peers.Reliable))
+	CrawlerPeers.WithLabelValues(kind, namespace, name, "ipv4").Set(float64(peers.IPv4))
+	CrawlerPeers.WithLabelValues(kind, namespace, name, "ipv6").Set(float64(peers.IPv6))
+	CrawlerPeers.WithLabelValues(kind, namespace, name, "seen_last_hour").Set(float64(peers.SeenLastHour))
+
+	CrawlerPeerVersions.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": namespace, "name": name})
+	for _, version := range peers.Versions {
+		CrawlerPeerVersions.WithLabelValues(kind, namespace, name, version.Version).Set(float64(version.Count))
+	}
+}
+
+// DeleteCrawlerPeers removes the peer metrics of a ChiaCrawler or ChiaSeeder, so a deleted or unreachable crawler doesn't keep reporting stale counts
+func DeleteCrawlerPeers(kind, namespace, name string) {
+	labels := prometheus.Labels{"kind": kind, "namespace": namespace, "name": name}
+	CrawlerPeers.DeletePartialMatch(labels)
+	CrawlerPeerVersions.DeletePartialMatch(labels)
+}
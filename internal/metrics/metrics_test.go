@@ -1,61 +1,98 @@
 package metrics
 
 import (
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-func TestMetricsRegistration(t *testing.T) {
-	// Test that all metrics are registered
-	metrics := []prometheus.Collector{
-		ChiaCAs,
-		ChiaCertificates,
-		ChiaCrawlers,
-		ChiaDataLayers,
-		ChiaFarmers,
-		ChiaHarvesters,
-		ChiaIntroducers,
-		ChiaNodes,
-		ChiaNetworks,
-		ChiaSeeders,
-		ChiaTimelords,
-		ChiaWallets,
-	}
+func TestResourceCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, k8schianetv1.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&k8schianetv1.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a", Namespace: "mainnet"},
+			Status:     k8schianetv1.ChiaNodeStatus{Ready: true},
+		},
+		&k8schianetv1.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b", Namespace: "mainnet"},
+			Status:     k8schianetv1.ChiaNodeStatus{Ready: true},
+		},
+		&k8schianetv1.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-c", Namespace: "testnet"},
+		},
+		&k8schianetv1.ChiaFarmer{
+			ObjectMeta: metav1.ObjectMeta{Name: "farmer", Namespace: "mainnet"},
+			Status:     k8schianetv1.ChiaFarmerStatus{Ready: true},
+		},
+	).Build()
 
-	for _, metric := range metrics {
-		assert.NotNil(t, metric, "Metric should not be nil")
-	}
+	expected := `
+# HELP chia_operator_chiafarmer_total Number of ChiaFarmer objects controlled by this operator
+# TYPE chia_operator_chiafarmer_total gauge
+chia_operator_chiafarmer_total{namespace="mainnet",ready="true"} 1
+# HELP chia_operator_chianode_total Number of ChiaNode objects controlled by this operator
+# TYPE chia_operator_chianode_total gauge
+chia_operator_chianode_total{namespace="mainnet",ready="true"} 2
+chia_operator_chianode_total{namespace="testnet",ready="false"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(NewResourceCollector(reader), strings.NewReader(expected)))
 }
 
-func TestGaugeOperations(t *testing.T) {
-	// Test setting gauge values
-	tests := []struct {
-		name   string
-		metric prometheus.Gauge
-		value  float64
-	}{
-		{"ChiaCAs", ChiaCAs, 5},
-		{"ChiaCertificates", ChiaCertificates, 10},
-		{"ChiaCrawlers", ChiaCrawlers, 3},
-		{"ChiaDataLayers", ChiaDataLayers, 2},
-		{"ChiaFarmers", ChiaFarmers, 4},
-		{"ChiaHarvesters", ChiaHarvesters, 6},
-		{"ChiaIntroducers", ChiaIntroducers, 1},
-		{"ChiaNodes", ChiaNodes, 8},
-		{"ChiaNetworks", ChiaNetworks, 2},
-		{"ChiaSeeders", ChiaSeeders, 3},
-		{"ChiaTimelords", ChiaTimelords, 1},
-		{"ChiaWallets", ChiaWallets, 4},
+func TestRecordObjectAction(t *testing.T) {
+	controller := true
+	deploy := &metav1.ObjectMeta{
+		Name: "node",
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "ChiaFarmer", Name: "farmer", Controller: &controller},
+		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Verify we can set the gauge value without panicking
-			assert.NotPanics(t, func() {
-				tt.metric.Set(tt.value)
-			}, "Setting gauge value should not panic")
-		})
-	}
+	RecordObjectAction(deploy, "Deployment", ActionUpdate)
+	RecordObjectAction(deploy, "Deployment", ActionUpdate)
+	assert.Equal(t, float64(2), testutil.ToFloat64(ObjectActions.WithLabelValues("ChiaFarmer", "Deployment", ActionUpdate)))
+
+	// Objects without a controller reference are counted without an owner kind
+	RecordObjectAction(&metav1.ObjectMeta{Name: "orphan"}, "Service", ActionDelete)
+	assert.Equal(t, float64(1), testutil.ToFloat64(ObjectActions.WithLabelValues("", "Service", ActionDelete)))
+}
+
+func TestReconcileMetrics(t *testing.T) {
+	ObserveReconcileDuration("ChiaNode", time.Now().Add(-time.Second))
+	assert.Equal(t, 1, testutil.CollectAndCount(ReconcileDuration, "chia_operator_reconcile_duration_seconds"))
+
+	RecordReconcileError("ChiaNode", "statefulset")
+	assert.Equal(t, float64(1), testutil.ToFloat64(ReconcileErrors.WithLabelValues("ChiaNode", "statefulset")))
+}
+
+func TestCrawlerPeers(t *testing.T) {
+	SetCrawlerPeers("ChiaCrawler", "default", "crawler", k8schianetv1.CrawlerPeerStatus{
+		Total:    30000,
+		Reliable: 8000,
+		Versions: []k8schianetv1.CrawlerPeerVersion{
+			{Version: "2.4.4", Count: 10000},
+			{Version: "2.5.0", Count: 20000},
+		},
+	})
+	assert.Equal(t, float64(30000), testutil.ToFloat64(CrawlerPeers.WithLabelValues("ChiaCrawler", "default", "crawler", "total")))
+	assert.Equal(t, float64(8000), testutil.ToFloat64(CrawlerPeers.WithLabelValues("ChiaCrawler", "default", "crawler", "reliable")))
+	assert.Equal(t, 2, testutil.CollectAndCount(CrawlerPeerVersions))
+
+	// Versions no longer reported are removed
+	SetCrawlerPeers("ChiaCrawler", "default", "crawler", k8schianetv1.CrawlerPeerStatus{
+		Versions: []k8schianetv1.CrawlerPeerVersion{{Version: "2.5.0", Count: 30000}},
+	})
+	assert.Equal(t, 1, testutil.CollectAndCount(CrawlerPeerVersions))
+
+	DeleteCrawlerPeers("ChiaCrawler", "default", "crawler")
+	assert.Equal(t, 0, testutil.CollectAndCount(CrawlerPeers))
+	assert.Equal(t, 0, testutil.CollectAndCount(CrawlerPeerVersions))
 }
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaCAWebhookWithManager registers the ChiaCA validating webhook with the manager's webhook server
+func SetupChiaCAWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaCA{}).
+		WithValidator(&ChiaCACustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiaca,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiacas,verbs=create;update,versions=v1,name=vchiaca-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaCACustomValidator validates ChiaCAs when they're created or updated
+type ChiaCACustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaCACustomValidator{}
+
+// ValidateCreate validates a new ChiaCA
+func (v *ChiaCACustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ca, ok := obj.(*k8schianetv1.ChiaCA)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCA object but got %T", obj)
+	}
+	return validateChiaCAWarnings(ca), invalid(string(consts.ChiaCAKind), ca.Name, validateChiaCA(ca))
+}
+
+// ValidateUpdate validates an updated ChiaCA. Updates that don't change the spec aren't validated,
+// so a ChiaCA created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaCACustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaCA)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCA object for the old object but got %T", oldObj)
+	}
+	ca, ok := newObj.(*k8schianetv1.ChiaCA)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCA object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, ca.Spec) {
+		return nil, nil
+	}
+	return validateChiaCAWarnings(ca), invalid(string(consts.ChiaCAKind), ca.Name, validateChiaCA(ca))
+}
+
+// ValidateDelete allows every ChiaCA to be deleted
+func (v *ChiaCACustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaCA returns the invalid fields of a ChiaCA
+func validateChiaCA(ca *k8schianetv1.ChiaCA) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateSecretName(ca.Spec.Secret, specPath.Child("secret"))...)
+	errs = append(errs, validateChiaCAAdopt(ca.Spec.Adopt, specPath.Child("adopt"))...)
+	return errs
+}
+
+// validateChiaCAAdopt validates the existing private CA a ChiaCA adopts, which is either referenced in a Secret or set inline, but not both
+func validateChiaCAAdopt(adopt *k8schianetv1.ChiaCAAdopt, path *field.Path) field.ErrorList {
+	if adopt == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	inline := adopt.Certificate != "" || adopt.PrivateKey != ""
+	switch {
+	case adopt.SecretRef != nil && inline:
+		errs = append(errs, field.Forbidden(path.Child("secretRef"), "may not be set when certificate or privateKey are set"))
+	case adopt.SecretRef != nil:
+		errs = append(errs, validateSecretName(adopt.SecretRef.Name, path.Child("secretRef", "name"))...)
+	case adopt.Certificate == "":
+		errs = append(errs, field.Required(path.Child("certificate"), "an adopted CA needs a certificate when privateKey is set, or a secretRef"))
+	case adopt.PrivateKey == "":
+		errs = append(errs, field.Required(path.Child("privateKey"), "an adopted CA needs a privateKey when certificate is set, or a secretRef"))
+	}
+	return errs
+}
+
+// validateChiaCAWarnings returns warnings for the fields of a ChiaCA that are set but unused
+func validateChiaCAWarnings(ca *k8schianetv1.ChiaCA) admission.Warnings {
+	if ca.Spec.IssuerRef != nil && ca.Spec.Backend != k8schianetv1.CertificateBackendCertManager {
+		return admission.Warnings{"spec.issuerRef is only used with the CertManager backend"}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaCertificatesWebhookWithManager registers the ChiaCertificates validating webhook with the manager's webhook server
+func SetupChiaCertificatesWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaCertificates{}).
+		WithValidator(&ChiaCertificatesCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiacertificates,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiacertificates,verbs=create;update,versions=v1,name=vchiacertificates-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaCertificatesCustomValidator validates ChiaCertificates when they're created or updated
+type ChiaCertificatesCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaCertificatesCustomValidator{}
+
+// ValidateCreate validates a new ChiaCertificates
+func (v *ChiaCertificatesCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	certs, ok := obj.(*k8schianetv1.ChiaCertificates)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCertificates object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaCertificatesKind), certs.Name, validateChiaCertificates(certs))
+}
+
+// ValidateUpdate validates an updated ChiaCertificates. Updates that don't change the spec aren't validated,
+// so a ChiaCertificates created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaCertificatesCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaCertificates)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCertificates object for the old object but got %T", oldObj)
+	}
+	certs, ok := newObj.(*k8schianetv1.ChiaCertificates)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCertificates object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, certs.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaCertificatesKind), certs.Name, validateChiaCertificates(certs))
+}
+
+// ValidateDelete allows every ChiaCertificates to be deleted
+func (v *ChiaCertificatesCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaCertificates returns the invalid fields of a ChiaCertificates
+func validateChiaCertificates(certs *k8schianetv1.ChiaCertificates) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateSecretName(certs.Spec.Secret, specPath.Child("secret"))...)
+	errs = append(errs, validateSecretName(certs.Spec.CASecretName, specPath.Child("caSecretName"))...)
+
+	// The certificates Secret defaults to the name of the ChiaCertificates, and can't be the CA Secret, which it would overwrite
+	secretPath := specPath.Child("secret")
+	secretName := certs.Spec.Secret
+	if strings.TrimSpace(secretName) == "" {
+		secretPath = field.NewPath("metadata", "name")
+		secretName = certs.Name
+	}
+	if secretName == certs.Spec.CASecretName {
+		errs = append(errs, field.Invalid(secretPath, secretName, "the certificates Secret may not have the same name as the CA Secret in spec.caSecretName"))
+	}
+
+	if certs.Spec.RenewBefore != nil && certs.Spec.RenewBefore.Duration <= 0 {
+		errs = append(errs, field.Invalid(specPath.Child("renewBefore"), certs.Spec.RenewBefore.Duration.String(), "must be a positive duration"))
+	}
+	return errs
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaCrawlerWebhookWithManager registers the ChiaCrawler validating webhook with the manager's webhook server
+func SetupChiaCrawlerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaCrawler{}).
+		WithValidator(&ChiaCrawlerCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiacrawler,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiacrawlers,verbs=create;update,versions=v1,name=vchiacrawler-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaCrawlerCustomValidator validates ChiaCrawlers when they're created or updated
+type ChiaCrawlerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaCrawlerCustomValidator{}
+
+// ValidateCreate validates a new ChiaCrawler
+func (v *ChiaCrawlerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	crawler, ok := obj.(*k8schianetv1.ChiaCrawler)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCrawler object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaCrawlerKind), crawler.Name, validateChiaCrawler(crawler))
+}
+
+// ValidateUpdate validates an updated ChiaCrawler. Updates that don't change the spec aren't validated,
+// so a ChiaCrawler created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaCrawlerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaCrawler)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCrawler object for the old object but got %T", oldObj)
+	}
+	crawler, ok := newObj.(*k8schianetv1.ChiaCrawler)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaCrawler object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, crawler.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaCrawlerKind), crawler.Name, validateChiaCrawler(crawler))
+}
+
+// ValidateDelete allows every ChiaCrawler to be deleted
+func (v *ChiaCrawlerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaCrawler returns the invalid fields of a ChiaCrawler
+func validateChiaCrawler(crawler *k8schianetv1.ChiaCrawler) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(crawler.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(crawler.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validatePodDisruptionBudget(crawler.Spec.PodDisruptionBudget, specPath.Child("podDisruptionBudget"))...)
+	errs = append(errs, validateReplicas(crawler.Spec.Replicas, crawler.Spec.Autoscaling, crawler.Spec.Storage, specPath)...)
+	return errs
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/chiadatalayer/fileserver"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaDataLayerWebhookWithManager registers the ChiaDataLayer validating webhook with the manager's webhook server
+func SetupChiaDataLayerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaDataLayer{}).
+		WithValidator(&ChiaDataLayerCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiadatalayer,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiadatalayers,verbs=create;update,versions=v1,name=vchiadatalayer-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaDataLayerCustomValidator validates ChiaDataLayers when they're created or updated
+type ChiaDataLayerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaDataLayerCustomValidator{}
+
+// ValidateCreate validates a new ChiaDataLayer
+func (v *ChiaDataLayerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	datalayer, ok := obj.(*k8schianetv1.ChiaDataLayer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaDataLayer object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaDataLayerKind), datalayer.Name, validateChiaDataLayer(datalayer))
+}
+
+// ValidateUpdate validates an updated ChiaDataLayer. Updates that don't change the spec aren't validated,
+// so a ChiaDataLayer created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaDataLayerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaDataLayer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaDataLayer object for the old object but got %T", oldObj)
+	}
+	datalayer, ok := newObj.(*k8schianetv1.ChiaDataLayer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaDataLayer object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, datalayer.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaDataLayerKind), datalayer.Name, validateChiaDataLayer(datalayer))
+}
+
+// ValidateDelete allows every ChiaDataLayer to be deleted
+func (v *ChiaDataLayerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaDataLayer returns the invalid fields of a ChiaDataLayer
+func validateChiaDataLayer(datalayer *k8schianetv1.ChiaDataLayer) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(datalayer.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(datalayer.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validatePeers(datalayer.Spec.ChiaConfig.FullNodePeers, chiaSpecPath.Child("fullNodePeers"))...)
+	errs = append(errs, validateTrustedCIDRs(datalayer.Spec.ChiaConfig.TrustedCIDRs, chiaSpecPath.Child("trustedCIDRs"))...)
+	errs = append(errs, validateFileserver(*datalayer, specPath)...)
+	return errs
+}
+
+// validateFileserver validates a ChiaDataLayer's fileserver. A fileserver that runs in a Deployment of its own shares the data_layer server files claim with the datalayer's pod.
+func validateFileserver(datalayer k8schianetv1.ChiaDataLayer, path *field.Path) field.ErrorList {
+	fileserverPath := path.Child("fileserver")
+	errs := validateAutoscaling(datalayer.Spec.FileserverConfig.Autoscaling, fileserverPath.Child("autoscaling"))
+	errs = append(errs, validatePodDisruptionBudget(datalayer.Spec.FileserverConfig.PodDisruptionBudget, fileserverPath.Child("podDisruptionBudget"))...)
+	if !fileserver.ShouldRunSeparately(datalayer) {
+		return errs
+	}
+
+	claimPath := path.Child("storage", "dataLayerServerFiles", "persistentVolumeClaim")
+	storage := datalayer.Spec.Storage
+	if storage == nil || storage.DataLayerServerFiles == nil || storage.DataLayerServerFiles.PersistentVolumeClaim == nil {
+		return append(errs, field.Required(claimPath, "is required when the fileserver runs in its own Deployment"))
+	}
+	return append(errs, validateShareableClaim(storage.DataLayerServerFiles.PersistentVolumeClaim, claimPath)...)
+}
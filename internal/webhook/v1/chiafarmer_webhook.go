@@ -0,0 +1,74 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaFarmerWebhookWithManager registers the ChiaFarmer validating webhook with the manager's webhook server
+func SetupChiaFarmerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaFarmer{}).
+		WithValidator(&ChiaFarmerCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiafarmer,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiafarmers,verbs=create;update,versions=v1,name=vchiafarmer-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaFarmerCustomValidator validates ChiaFarmers when they're created or updated
+type ChiaFarmerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaFarmerCustomValidator{}
+
+// ValidateCreate validates a new ChiaFarmer
+func (v *ChiaFarmerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	farmer, ok := obj.(*k8schianetv1.ChiaFarmer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaFarmer object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaFarmerKind), farmer.Name, validateChiaFarmer(farmer))
+}
+
+// ValidateUpdate validates an updated ChiaFarmer. Updates that don't change the spec aren't validated,
+// so a ChiaFarmer created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaFarmerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaFarmer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaFarmer object for the old object but got %T", oldObj)
+	}
+	farmer, ok := newObj.(*k8schianetv1.ChiaFarmer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaFarmer object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, farmer.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaFarmerKind), farmer.Name, validateChiaFarmer(farmer))
+}
+
+// ValidateDelete allows every ChiaFarmer to be deleted
+func (v *ChiaFarmerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaFarmer returns the invalid fields of a ChiaFarmer
+func validateChiaFarmer(farmer *k8schianetv1.ChiaFarmer) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(farmer.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(farmer.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validateFullNodePeers(farmer.Spec.ChiaConfig.FullNodePeer, farmer.Spec.ChiaConfig.FullNodePeers, chiaSpecPath)...)
+	return errs
+}
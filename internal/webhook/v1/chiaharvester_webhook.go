@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaHarvesterWebhookWithManager registers the ChiaHarvester validating webhook with the manager's webhook server
+func SetupChiaHarvesterWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaHarvester{}).
+		WithValidator(&ChiaHarvesterCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiaharvester,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiaharvesters,verbs=create;update,versions=v1,name=vchiaharvester-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaHarvesterCustomValidator validates ChiaHarvesters when they're created or updated
+type ChiaHarvesterCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaHarvesterCustomValidator{}
+
+// ValidateCreate validates a new ChiaHarvester
+func (v *ChiaHarvesterCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	harvester, ok := obj.(*k8schianetv1.ChiaHarvester)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaHarvester object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaHarvesterKind), harvester.Name, validateChiaHarvester(harvester))
+}
+
+// ValidateUpdate validates an updated ChiaHarvester. Updates that don't change the spec aren't validated,
+// so a ChiaHarvester created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaHarvesterCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaHarvester)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaHarvester object for the old object but got %T", oldObj)
+	}
+	harvester, ok := newObj.(*k8schianetv1.ChiaHarvester)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaHarvester object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, harvester.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaHarvesterKind), harvester.Name, validateChiaHarvester(harvester))
+}
+
+// ValidateDelete allows every ChiaHarvester to be deleted
+func (v *ChiaHarvesterCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaHarvester returns the invalid fields of a ChiaHarvester
+func validateChiaHarvester(harvester *k8schianetv1.ChiaHarvester) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(harvester.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(harvester.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	return errs
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaIntroducerWebhookWithManager registers the ChiaIntroducer validating webhook with the manager's webhook server
+func SetupChiaIntroducerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaIntroducer{}).
+		WithValidator(&ChiaIntroducerCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiaintroducer,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiaintroducers,verbs=create;update,versions=v1,name=vchiaintroducer-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaIntroducerCustomValidator validates ChiaIntroducers when they're created or updated
+type ChiaIntroducerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaIntroducerCustomValidator{}
+
+// ValidateCreate validates a new ChiaIntroducer
+func (v *ChiaIntroducerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	introducer, ok := obj.(*k8schianetv1.ChiaIntroducer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaIntroducer object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaIntroducerKind), introducer.Name, validateChiaIntroducer(introducer))
+}
+
+// ValidateUpdate validates an updated ChiaIntroducer. Updates that don't change the spec aren't validated,
+// so a ChiaIntroducer created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaIntroducerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaIntroducer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaIntroducer object for the old object but got %T", oldObj)
+	}
+	introducer, ok := newObj.(*k8schianetv1.ChiaIntroducer)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaIntroducer object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, introducer.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaIntroducerKind), introducer.Name, validateChiaIntroducer(introducer))
+}
+
+// ValidateDelete allows every ChiaIntroducer to be deleted
+func (v *ChiaIntroducerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaIntroducer returns the invalid fields of a ChiaIntroducer
+func validateChiaIntroducer(introducer *k8schianetv1.ChiaIntroducer) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(introducer.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(introducer.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validatePodDisruptionBudget(introducer.Spec.PodDisruptionBudget, specPath.Child("podDisruptionBudget"))...)
+	errs = append(errs, validateReplicas(introducer.Spec.Replicas, introducer.Spec.Autoscaling, introducer.Spec.Storage, specPath)...)
+	return errs
+}
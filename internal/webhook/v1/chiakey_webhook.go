@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaKeyWebhookWithManager registers the ChiaKey validating webhook with the manager's webhook server
+func SetupChiaKeyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaKey{}).
+		WithValidator(&ChiaKeyCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiakey,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiakeys,verbs=create;update,versions=v1,name=vchiakey-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaKeyCustomValidator validates ChiaKeys when they're created or updated
+type ChiaKeyCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaKeyCustomValidator{}
+
+// ValidateCreate validates a new ChiaKey
+func (v *ChiaKeyCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	key, ok := obj.(*k8schianetv1.ChiaKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaKey object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaKeyKind), key.Name, validateChiaKey(key))
+}
+
+// ValidateUpdate validates an updated ChiaKey. Updates that don't change the spec aren't validated,
+// so a ChiaKey created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaKeyCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaKey object for the old object but got %T", oldObj)
+	}
+	key, ok := newObj.(*k8schianetv1.ChiaKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaKey object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, key.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaKeyKind), key.Name, validateChiaKey(key))
+}
+
+// ValidateDelete allows every ChiaKey to be deleted
+func (v *ChiaKeyCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaKey returns the invalid fields of a ChiaKey
+func validateChiaKey(key *k8schianetv1.ChiaKey) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateSecretName(key.Spec.Secret, specPath.Child("secret"))...)
+	if key.Spec.Key != "" {
+		for _, msg := range validation.IsConfigMapKey(key.Spec.Key) {
+			errs = append(errs, field.Invalid(specPath.Child("key"), key.Spec.Key, msg))
+		}
+	}
+	return errs
+}
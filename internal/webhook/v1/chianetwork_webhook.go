@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaNetworkWebhookWithManager registers the ChiaNetwork validating webhook with the manager's webhook server
+func SetupChiaNetworkWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaNetwork{}).
+		WithValidator(&ChiaNetworkCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chianetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chianetworks,verbs=create;update,versions=v1,name=vchianetwork-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaNetworkCustomValidator validates ChiaNetworks when they're created or updated
+type ChiaNetworkCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaNetworkCustomValidator{}
+
+// ValidateCreate validates a new ChiaNetwork
+func (v *ChiaNetworkCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	network, ok := obj.(*k8schianetv1.ChiaNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNetwork object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaNetworkKind), network.Name, validateChiaNetwork(network))
+}
+
+// ValidateUpdate validates an updated ChiaNetwork. Updates that don't change the spec aren't validated,
+// so a ChiaNetwork created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaNetworkCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNetwork object for the old object but got %T", oldObj)
+	}
+	network, ok := newObj.(*k8schianetv1.ChiaNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNetwork object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, network.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaNetworkKind), network.Name, validateChiaNetwork(network))
+}
+
+// ValidateDelete allows every ChiaNetwork to be deleted
+func (v *ChiaNetworkCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaNetwork returns the invalid fields of a ChiaNetwork
+func validateChiaNetwork(network *k8schianetv1.ChiaNetwork) field.ErrorList {
+	var errs field.ErrorList
+	if network.Spec.NetworkPort != nil && *network.Spec.NetworkPort == 0 {
+		errs = append(errs, field.Invalid(specPath.Child("networkPort"), *network.Spec.NetworkPort, "must be a port number from 1 to 65535"))
+	}
+	errs = append(errs, validateIntroducerAddress(network.Spec.IntroducerAddress, specPath.Child("introducerAddress"))...)
+	return errs
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaNodeWebhookWithManager registers the ChiaNode validating webhook with the manager's webhook server
+func SetupChiaNodeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaNode{}).
+		WithValidator(&ChiaNodeCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chianode,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chianodes,verbs=create;update,versions=v1,name=vchianode-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaNodeCustomValidator validates ChiaNodes when they're created or updated
+type ChiaNodeCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaNodeCustomValidator{}
+
+// ValidateCreate validates a new ChiaNode
+func (v *ChiaNodeCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	node, ok := obj.(*k8schianetv1.ChiaNode)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNode object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaNodeKind), node.Name, validateChiaNode(node))
+}
+
+// ValidateUpdate validates an updated ChiaNode. Updates that don't change the spec aren't validated,
+// so a ChiaNode created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaNodeCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaNode)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNode object for the old object but got %T", oldObj)
+	}
+	node, ok := newObj.(*k8schianetv1.ChiaNode)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaNode object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, node.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaNodeKind), node.Name, validateChiaNode(node))
+}
+
+// ValidateDelete allows every ChiaNode to be deleted
+func (v *ChiaNodeCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaNode returns the invalid fields of a ChiaNode
+func validateChiaNode(node *k8schianetv1.ChiaNode) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(node.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(node.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validatePeers(node.Spec.ChiaConfig.FullNodePeers, chiaSpecPath.Child("fullNodePeers"))...)
+	errs = append(errs, validateTrustedCIDRs(node.Spec.ChiaConfig.TrustedCIDRs, chiaSpecPath.Child("trustedCIDRs"))...)
+	errs = append(errs, validatePodDisruptionBudget(node.Spec.PodDisruptionBudget, specPath.Child("podDisruptionBudget"))...)
+	return errs
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaSeederWebhookWithManager registers the ChiaSeeder validating webhook with the manager's webhook server
+func SetupChiaSeederWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaSeeder{}).
+		WithValidator(&ChiaSeederCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiaseeder,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiaseeders,verbs=create;update,versions=v1,name=vchiaseeder-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaSeederCustomValidator validates ChiaSeeders when they're created or updated
+type ChiaSeederCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaSeederCustomValidator{}
+
+// ValidateCreate validates a new ChiaSeeder
+func (v *ChiaSeederCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	seeder, ok := obj.(*k8schianetv1.ChiaSeeder)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaSeeder object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaSeederKind), seeder.Name, validateChiaSeeder(seeder))
+}
+
+// ValidateUpdate validates an updated ChiaSeeder. Updates that don't change the spec aren't validated,
+// so a ChiaSeeder created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaSeederCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaSeeder)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaSeeder object for the old object but got %T", oldObj)
+	}
+	seeder, ok := newObj.(*k8schianetv1.ChiaSeeder)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaSeeder object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, seeder.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaSeederKind), seeder.Name, validateChiaSeeder(seeder))
+}
+
+// ValidateDelete allows every ChiaSeeder to be deleted
+func (v *ChiaSeederCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaSeeder returns the invalid fields of a ChiaSeeder
+func validateChiaSeeder(seeder *k8schianetv1.ChiaSeeder) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(seeder.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(seeder.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validatePodDisruptionBudget(seeder.Spec.PodDisruptionBudget, specPath.Child("podDisruptionBudget"))...)
+	errs = append(errs, validateReplicas(seeder.Spec.Replicas, seeder.Spec.Autoscaling, seeder.Spec.Storage, specPath)...)
+	if seeder.Spec.ChiaConfig.BootstrapPeer != nil && *seeder.Spec.ChiaConfig.BootstrapPeer != "" && seeder.Spec.ChiaConfig.BootstrapPeers != nil && len(*seeder.Spec.ChiaConfig.BootstrapPeers) != 0 {
+		errs = append(errs, field.Forbidden(chiaSpecPath.Child("bootstrapPeers"), "may not be set when bootstrapPeer is set"))
+	}
+	return errs
+}
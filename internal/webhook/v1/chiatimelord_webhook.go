@@ -0,0 +1,74 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaTimelordWebhookWithManager registers the ChiaTimelord validating webhook with the manager's webhook server
+func SetupChiaTimelordWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaTimelord{}).
+		WithValidator(&ChiaTimelordCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiatimelord,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiatimelords,verbs=create;update,versions=v1,name=vchiatimelord-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaTimelordCustomValidator validates ChiaTimelords when they're created or updated
+type ChiaTimelordCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaTimelordCustomValidator{}
+
+// ValidateCreate validates a new ChiaTimelord
+func (v *ChiaTimelordCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tl, ok := obj.(*k8schianetv1.ChiaTimelord)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaTimelord object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaTimelordKind), tl.Name, validateChiaTimelord(tl))
+}
+
+// ValidateUpdate validates an updated ChiaTimelord. Updates that don't change the spec aren't validated,
+// so a ChiaTimelord created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaTimelordCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaTimelord)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaTimelord object for the old object but got %T", oldObj)
+	}
+	tl, ok := newObj.(*k8schianetv1.ChiaTimelord)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaTimelord object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, tl.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaTimelordKind), tl.Name, validateChiaTimelord(tl))
+}
+
+// ValidateDelete allows every ChiaTimelord to be deleted
+func (v *ChiaTimelordCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaTimelord returns the invalid fields of a ChiaTimelord
+func validateChiaTimelord(tl *k8schianetv1.ChiaTimelord) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(tl.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(tl.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validateFullNodePeers(tl.Spec.ChiaConfig.FullNodePeer, tl.Spec.ChiaConfig.FullNodePeers, chiaSpecPath)...)
+	return errs
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/consts"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupChiaWalletWebhookWithManager registers the ChiaWallet validating webhook with the manager's webhook server
+func SetupChiaWalletWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&k8schianetv1.ChiaWallet{}).
+		WithValidator(&ChiaWalletCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-k8s-chia-net-v1-chiawallet,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.chia.net,resources=chiawallets,verbs=create;update,versions=v1,name=vchiawallet-v1.kb.io,admissionReviewVersions=v1
+
+// ChiaWalletCustomValidator validates ChiaWallets when they're created or updated
+type ChiaWalletCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ChiaWalletCustomValidator{}
+
+// ValidateCreate validates a new ChiaWallet
+func (v *ChiaWalletCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	wallet, ok := obj.(*k8schianetv1.ChiaWallet)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaWallet object but got %T", obj)
+	}
+	return nil, invalid(string(consts.ChiaWalletKind), wallet.Name, validateChiaWallet(wallet))
+}
+
+// ValidateUpdate validates an updated ChiaWallet. Updates that don't change the spec aren't validated,
+// so a ChiaWallet created before the webhook was installed can still have its metadata updated and be deleted.
+func (v *ChiaWalletCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*k8schianetv1.ChiaWallet)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaWallet object for the old object but got %T", oldObj)
+	}
+	wallet, ok := newObj.(*k8schianetv1.ChiaWallet)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChiaWallet object for the new object but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, wallet.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(string(consts.ChiaWalletKind), wallet.Name, validateChiaWallet(wallet))
+}
+
+// ValidateDelete allows every ChiaWallet to be deleted
+func (v *ChiaWalletCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateChiaWallet returns the invalid fields of a ChiaWallet
+func validateChiaWallet(wallet *k8schianetv1.ChiaWallet) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateCommonSpec(wallet.Spec.CommonSpec, specPath)...)
+	errs = append(errs, validateCommonSpecChia(wallet.Spec.ChiaConfig.CommonSpecChia, chiaSpecPath)...)
+	errs = append(errs, validateFullNodePeers(wallet.Spec.ChiaConfig.FullNodePeer, wallet.Spec.ChiaConfig.FullNodePeers, chiaSpecPath)...)
+	errs = append(errs, validateTrustedCIDRs(wallet.Spec.ChiaConfig.TrustedCIDRs, chiaSpecPath.Child("trustedCIDRs"))...)
+	return errs
+}
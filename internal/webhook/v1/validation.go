@@ -0,0 +1,277 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/chia-network/chia-operator/internal/controller/common/kube"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	specPath     = field.NewPath("spec")
+	chiaSpecPath = specPath.Child("chia")
+)
+
+// invalid returns an Invalid API error for a Chia resource if any of its fields are invalid, or nil if there are none
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: k8schianetv1.GroupVersion.Group, Kind: kind}, name, errs)
+}
+
+// validateCommonSpec validates the fields every Chia component resource has at the top level of its spec
+func validateCommonSpec(spec k8schianetv1.CommonSpec, path *field.Path) field.ErrorList {
+	errs := validateStorage(spec.Storage, path.Child("storage"))
+	errs = append(errs, validatePodTemplate(spec.PodTemplate, path.Child("podTemplate"))...)
+	return errs
+}
+
+// validatePodTemplate validates a podTemplate by applying it over an empty pod template, the same way the controllers apply it over the pod templates they generate
+func validatePodTemplate(podTemplate *runtime.RawExtension, path *field.Path) field.ErrorList {
+	if err := kube.ApplyPodTemplate(&corev1.PodTemplateSpec{}, podTemplate); err != nil {
+		return field.ErrorList{field.Invalid(path, string(podTemplate.Raw), err.Error())}
+	}
+	return nil
+}
+
+// validateCommonSpecChia validates the fields every Chia component resource has in its chia config
+func validateCommonSpecChia(spec k8schianetv1.CommonSpecChia, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if spec.NetworkPort != nil && *spec.NetworkPort == 0 {
+		errs = append(errs, field.Invalid(path.Child("networkPort"), *spec.NetworkPort, "must be a port number from 1 to 65535"))
+	}
+	errs = append(errs, validateIntroducerAddress(spec.IntroducerAddress, path.Child("introducerAddress"))...)
+	return errs
+}
+
+// validateIntroducerAddress validates an introducer address, which is a hostname or IP address without a port
+func validateIntroducerAddress(address *string, path *field.Path) field.ErrorList {
+	if address == nil {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(*address); err == nil {
+		return field.ErrorList{field.Invalid(path, *address, "must not include a port, the port is taken from networkPort")}
+	}
+	return nil
+}
+
+// validateStorage validates a Chia resource's storage config
+func validateStorage(storage *k8schianetv1.StorageConfig, path *field.Path) field.ErrorList {
+	if storage == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if storage.ChiaRoot != nil {
+		errs = append(errs, validateExclusiveVolume(storage.ChiaRoot.PersistentVolumeClaim, storage.ChiaRoot.HostPathVolume, path.Child("chiaRoot"))...)
+	}
+	if storage.Plots != nil {
+		for i, pvc := range storage.Plots.PersistentVolumeClaim {
+			errs = append(errs, validatePersistentVolumeClaim(pvc, path.Child("plots", "persistentVolumeClaim").Index(i))...)
+		}
+		for i, hostPath := range storage.Plots.HostPathVolume {
+			errs = append(errs, validateHostPathVolume(hostPath, path.Child("plots", "hostPathVolume").Index(i))...)
+		}
+	}
+	if storage.DataLayerServerFiles != nil {
+		errs = append(errs, validateExclusiveVolume(storage.DataLayerServerFiles.PersistentVolumeClaim, storage.DataLayerServerFiles.HostPathVolume, path.Child("dataLayerServerFiles"))...)
+	}
+	return errs
+}
+
+// validateExclusiveVolume validates a storage config that can be either a PVC or a hostPath volume, but not both
+func validateExclusiveVolume(pvc *k8schianetv1.PersistentVolumeClaimConfig, hostPath *k8schianetv1.HostPathVolumeConfig, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if pvc != nil && hostPath != nil {
+		errs = append(errs, field.Forbidden(path.Child("hostPathVolume"), "may not be set when persistentVolumeClaim is set"))
+	}
+	errs = append(errs, validatePersistentVolumeClaim(pvc, path.Child("persistentVolumeClaim"))...)
+	errs = append(errs, validateHostPathVolume(hostPath, path.Child("hostPathVolume"))...)
+	return errs
+}
+
+// validatePersistentVolumeClaim validates a PVC volume config
+func validatePersistentVolumeClaim(pvc *k8schianetv1.PersistentVolumeClaimConfig, path *field.Path) field.ErrorList {
+	if pvc == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if pvc.ClaimName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(pvc.ClaimName) {
+			errs = append(errs, field.Invalid(path.Child("claimName"), pvc.ClaimName, msg))
+		}
+	}
+	if pvc.ResourceRequest != "" {
+		if _, err := resource.ParseQuantity(pvc.ResourceRequest); err != nil {
+			errs = append(errs, field.Invalid(path.Child("resourceRequest"), pvc.ResourceRequest, "must be a quantity, such as 300Gi"))
+		}
+	}
+	return errs
+}
+
+// validateHostPathVolume validates a hostPath volume config
+func validateHostPathVolume(hostPath *k8schianetv1.HostPathVolumeConfig, path *field.Path) field.ErrorList {
+	if hostPath == nil {
+		return nil
+	}
+	if hostPath.Path == "" {
+		return field.ErrorList{field.Required(path.Child("path"), "a hostPath volume needs a path on the host")}
+	}
+	return nil
+}
+
+// validateFullNodePeers validates the full_node peers a Chia component connects to, which are either set as one fullNodePeer address or a fullNodePeers list
+func validateFullNodePeers(fullNodePeer *string, fullNodePeers *[]k8schianetv1.Peer, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if fullNodePeer != nil && *fullNodePeer != "" && fullNodePeers != nil && len(*fullNodePeers) != 0 {
+		errs = append(errs, field.Forbidden(path.Child("fullNodePeers"), "may not be set when fullNodePeer is set"))
+	}
+	if fullNodePeer != nil && *fullNodePeer != "" {
+		errs = append(errs, validateHostPort(*fullNodePeer, path.Child("fullNodePeer"))...)
+	}
+	errs = append(errs, validatePeers(fullNodePeers, path.Child("fullNodePeers"))...)
+	return errs
+}
+
+// validatePeers validates a list of peers
+func validatePeers(peers *[]k8schianetv1.Peer, path *field.Path) field.ErrorList {
+	if peers == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i, peer := range *peers {
+		if peer.Host == "" {
+			errs = append(errs, field.Required(path.Index(i).Child("host"), "a peer needs a hostname or IP address"))
+		}
+		if peer.Port == 0 {
+			errs = append(errs, field.Invalid(path.Index(i).Child("port"), peer.Port, "must be a port number from 1 to 65535"))
+		}
+	}
+	return errs
+}
+
+// validateHostPort validates a peer address in the host:port form
+func validateHostPort(address string, path *field.Path) field.ErrorList {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, address, "must be a host and port, such as node.chia.svc.cluster.local:8444")}
+	}
+	if host == "" {
+		return field.ErrorList{field.Invalid(path, address, "must include a hostname or IP address")}
+	}
+	if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+		return field.ErrorList{field.Invalid(path, address, "must include a port number from 1 to 65535")}
+	}
+	return nil
+}
+
+// validateTrustedCIDRs validates a list of CIDRs a Chia component trusts peers from
+func validateTrustedCIDRs(cidrs *[]string, path *field.Path) field.ErrorList {
+	if cidrs == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i, cidr := range *cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), cidr, "must be a CIDR, such as 10.0.0.0/8"))
+		}
+	}
+	return errs
+}
+
+// validatePodDisruptionBudget validates a PodDisruptionBudget config, which may set minAvailable or maxUnavailable but not both
+func validatePodDisruptionBudget(pdb *k8schianetv1.PodDisruptionBudgetConfig, path *field.Path) field.ErrorList {
+	if pdb == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		errs = append(errs, field.Forbidden(path.Child("maxUnavailable"), "may not be set when minAvailable is set"))
+	}
+	errs = append(errs, validateIntOrPercent(pdb.MinAvailable, path.Child("minAvailable"))...)
+	errs = append(errs, validateIntOrPercent(pdb.MaxUnavailable, path.Child("maxUnavailable"))...)
+	return errs
+}
+
+// validateIntOrPercent validates a count of pods, which is either a non-negative number or a percentage
+func validateIntOrPercent(value *intstr.IntOrString, path *field.Path) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+
+	if value.Type == intstr.Int {
+		if value.IntVal < 0 {
+			return field.ErrorList{field.Invalid(path, value.IntVal, "must be greater than or equal to 0")}
+		}
+		return nil
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+	if !strings.HasSuffix(value.StrVal, "%") || err != nil || percent < 0 || percent > 100 {
+		return field.ErrorList{field.Invalid(path, value.StrVal, "must be a number, or a percentage from 0% to 100%, such as 50%")}
+	}
+	return nil
+}
+
+// validateReplicas validates the replicas and autoscaling of a resource that runs in a Deployment, whose replicas all mount the same CHIA_ROOT volume
+func validateReplicas(replicas int32, autoscaling *k8schianetv1.AutoscalingConfig, storage *k8schianetv1.StorageConfig, path *field.Path) field.ErrorList {
+	errs := validateAutoscaling(autoscaling, path.Child("autoscaling"))
+	if kube.GetMaxReplicas(replicas, autoscaling) > 1 && storage != nil && storage.ChiaRoot != nil {
+		errs = append(errs, validateShareableClaim(storage.ChiaRoot.PersistentVolumeClaim, path.Child("storage", "chiaRoot", "persistentVolumeClaim"))...)
+	}
+	return errs
+}
+
+// validateAutoscaling validates an autoscaling config, which needs a maxReplicas of at least its minReplicas when it's enabled
+func validateAutoscaling(autoscaling *k8schianetv1.AutoscalingConfig, path *field.Path) field.ErrorList {
+	if !kube.ShouldMakeHorizontalPodAutoscaler(autoscaling) {
+		return nil
+	}
+	if autoscaling.MaxReplicas < 1 {
+		return field.ErrorList{field.Required(path.Child("maxReplicas"), "is required when autoscaling is enabled")}
+	}
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas > autoscaling.MaxReplicas {
+		return field.ErrorList{field.Invalid(path.Child("minReplicas"), *autoscaling.MinReplicas, "may not be greater than maxReplicas")}
+	}
+	return nil
+}
+
+// validateShareableClaim validates that a generated PVC can be mounted by replicas on several nodes at once.
+// Existing claims are checked by the controllers instead, since their access modes aren't known until they're read.
+func validateShareableClaim(pvc *k8schianetv1.PersistentVolumeClaimConfig, path *field.Path) field.ErrorList {
+	if pvc == nil || !pvc.GenerateVolumeClaims || kube.AccessModesShareable(pvc.AccessModes) {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(path.Child("accessModes"), "must include ReadWriteMany when the claim is shared by more than one replica")}
+}
+
+// validateSecretName validates the name of a Secret a Chia resource references or generates
+func validateSecretName(name string, path *field.Path) field.ErrorList {
+	if name == "" {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		errs = append(errs, field.Invalid(path, name, msg))
+	}
+	return errs
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateStorage(t *testing.T) {
+	path := field.NewPath("spec", "storage")
+
+	assert.Empty(t, validateStorage(nil, path))
+	assert.Empty(t, validateStorage(&k8schianetv1.StorageConfig{
+		ChiaRoot: &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "chiaroot", ResourceRequest: "300Gi"},
+		},
+	}, path))
+
+	// Both a PVC and a hostPath volume for CHIA_ROOT
+	errs := validateStorage(&k8schianetv1.StorageConfig{
+		ChiaRoot: &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "chiaroot"},
+			HostPathVolume:        &k8schianetv1.HostPathVolumeConfig{Path: "/mnt/chiaroot"},
+		},
+	}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.storage.chiaRoot.hostPathVolume", errs[0].Field)
+
+	// Both a PVC and a hostPath volume for data_layer server files
+	errs = validateStorage(&k8schianetv1.StorageConfig{
+		DataLayerServerFiles: &k8schianetv1.DataLayerServerFilesConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "files"},
+			HostPathVolume:        &k8schianetv1.HostPathVolumeConfig{Path: "/mnt/files"},
+		},
+	}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.storage.dataLayerServerFiles.hostPathVolume", errs[0].Field)
+
+	// Invalid plot volumes
+	errs = validateStorage(&k8schianetv1.StorageConfig{
+		Plots: &k8schianetv1.PlotsConfig{
+			PersistentVolumeClaim: []*k8schianetv1.PersistentVolumeClaimConfig{{ClaimName: "plots"}, {ClaimName: "Not_A_Name"}},
+			HostPathVolume:        []*k8schianetv1.HostPathVolumeConfig{{}},
+		},
+	}, path)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.storage.plots.persistentVolumeClaim[1].claimName", errs[0].Field)
+	assert.Equal(t, "spec.storage.plots.hostPathVolume[0].path", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+
+	// Invalid resource request
+	errs = validateStorage(&k8schianetv1.StorageConfig{
+		ChiaRoot: &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{GenerateVolumeClaims: true, ResourceRequest: "lots"},
+		},
+	}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.storage.chiaRoot.persistentVolumeClaim.resourceRequest", errs[0].Field)
+}
+
+func TestValidateFullNodePeers(t *testing.T) {
+	path := field.NewPath("spec", "chia")
+
+	assert.Empty(t, validateFullNodePeers(nil, nil, path))
+	assert.Empty(t, validateFullNodePeers(ptr.To("node.chia.svc.cluster.local:8444"), nil, path))
+	assert.Empty(t, validateFullNodePeers(ptr.To("[fd00::1]:8444"), nil, path))
+	assert.Empty(t, validateFullNodePeers(nil, &[]k8schianetv1.Peer{{Host: "node", Port: 8444}}, path))
+
+	// Both fullNodePeer and fullNodePeers
+	errs := validateFullNodePeers(ptr.To("node:8444"), &[]k8schianetv1.Peer{{Host: "node", Port: 8444}}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.chia.fullNodePeers", errs[0].Field)
+
+	// An empty fullNodePeers list doesn't conflict
+	assert.Empty(t, validateFullNodePeers(ptr.To("node:8444"), &[]k8schianetv1.Peer{}, path))
+
+	// Invalid fullNodePeer addresses
+	for _, address := range []string{"node", ":8444", "node:0", "node:http", "node:70000"} {
+		errs = validateFullNodePeers(ptr.To(address), nil, path)
+		require.Len(t, errs, 1, address)
+		assert.Equal(t, "spec.chia.fullNodePeer", errs[0].Field, address)
+	}
+
+	// Invalid peers
+	errs = validateFullNodePeers(nil, &[]k8schianetv1.Peer{{Host: "node", Port: 8444}, {Port: 8444}, {Host: "node"}}, path)
+	require.Len(t, errs, 2)
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.chia.fullNodePeers[1].host", errs[0].Field)
+	assert.Equal(t, "spec.chia.fullNodePeers[2].port", errs[1].Field)
+}
+
+func TestValidateTrustedCIDRs(t *testing.T) {
+	path := field.NewPath("spec", "chia", "trustedCIDRs")
+
+	assert.Empty(t, validateTrustedCIDRs(nil, path))
+	assert.Empty(t, validateTrustedCIDRs(&[]string{"10.0.0.0/8", "192.168.1.1/32", "fd00::/8"}, path))
+
+	errs := validateTrustedCIDRs(&[]string{"10.0.0.0/8", "10.0.0.1", "not-a-cidr"}, path)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.chia.trustedCIDRs[1]", errs[0].Field)
+	assert.Equal(t, "spec.chia.trustedCIDRs[2]", errs[1].Field)
+}
+
+func TestValidateCommonSpecChia(t *testing.T) {
+	path := field.NewPath("spec", "chia")
+
+	assert.Empty(t, validateCommonSpecChia(k8schianetv1.CommonSpecChia{
+		NetworkPort:       ptr.To(uint16(58444)),
+		IntroducerAddress: ptr.To("introducer.chia.net"),
+	}, path))
+	assert.Empty(t, validateCommonSpecChia(k8schianetv1.CommonSpecChia{IntroducerAddress: ptr.To("fd00::1")}, path))
+
+	errs := validateCommonSpecChia(k8schianetv1.CommonSpecChia{
+		NetworkPort:       ptr.To(uint16(0)),
+		IntroducerAddress: ptr.To("introducer.chia.net:8444"),
+	}, path)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.chia.networkPort", errs[0].Field)
+	assert.Equal(t, "spec.chia.introducerAddress", errs[1].Field)
+}
+
+func TestValidatePodTemplate(t *testing.T) {
+	path := field.NewPath("spec", "podTemplate")
+
+	assert.Empty(t, validatePodTemplate(nil, path))
+	assert.Empty(t, validatePodTemplate(&runtime.RawExtension{Raw: []byte(`{"spec": {"priorityClassName": "chia", "containers": [{"name": "chia", "$patch": "delete"}]}}`)}, path))
+
+	errs := validatePodTemplate(&runtime.RawExtension{Raw: []byte(`{"spec": {"tolerationz": []}}`)}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.podTemplate", errs[0].Field)
+	assert.Contains(t, errs[0].Detail, "tolerationz")
+}
+
+func TestValidatePodDisruptionBudget(t *testing.T) {
+	path := field.NewPath("spec", "podDisruptionBudget")
+
+	assert.Empty(t, validatePodDisruptionBudget(nil, path))
+	assert.Empty(t, validatePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{Enabled: ptr.To(true)}, path))
+	assert.Empty(t, validatePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{MinAvailable: ptr.To(intstr.FromInt32(2))}, path))
+	assert.Empty(t, validatePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{MaxUnavailable: ptr.To(intstr.FromString("25%"))}, path))
+
+	errs := validatePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{
+		MinAvailable:   ptr.To(intstr.FromInt32(-1)),
+		MaxUnavailable: ptr.To(intstr.FromString("150%")),
+	}, path)
+	require.Len(t, errs, 3)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.podDisruptionBudget.maxUnavailable", errs[0].Field)
+	assert.Equal(t, "spec.podDisruptionBudget.minAvailable", errs[1].Field)
+	assert.Equal(t, "spec.podDisruptionBudget.maxUnavailable", errs[2].Field)
+
+	errs = validatePodDisruptionBudget(&k8schianetv1.PodDisruptionBudgetConfig{MinAvailable: ptr.To(intstr.FromString("half"))}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.podDisruptionBudget.minAvailable", errs[0].Field)
+}
+
+func TestValidateAutoscaling(t *testing.T) {
+	path := field.NewPath("spec", "autoscaling")
+
+	assert.Empty(t, validateAutoscaling(nil, path))
+	assert.Empty(t, validateAutoscaling(&k8schianetv1.AutoscalingConfig{}, path), "disabled autoscaling doesn't need maxReplicas")
+	assert.Empty(t, validateAutoscaling(&k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true), MinReplicas: ptr.To(int32(2)), MaxReplicas: 2}, path))
+
+	errs := validateAutoscaling(&k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true)}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.autoscaling.maxReplicas", errs[0].Field)
+
+	errs = validateAutoscaling(&k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true), MinReplicas: ptr.To(int32(3)), MaxReplicas: 2}, path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	assert.Equal(t, "spec.autoscaling.minReplicas", errs[0].Field)
+}
+
+func TestValidateReplicas(t *testing.T) {
+	path := field.NewPath("spec")
+	storage := func(accessModes ...corev1.PersistentVolumeAccessMode) *k8schianetv1.StorageConfig {
+		return &k8schianetv1.StorageConfig{ChiaRoot: &k8schianetv1.ChiaRootConfig{PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{
+			GenerateVolumeClaims: true,
+			AccessModes:          accessModes,
+			ResourceRequest:      "10Gi",
+		}}}
+	}
+
+	// A single replica can use any claim
+	assert.Empty(t, validateReplicas(1, nil, storage(corev1.ReadWriteOnce), path))
+	// Several replicas need a claim they can share
+	assert.Empty(t, validateReplicas(3, nil, storage(corev1.ReadWriteMany), path))
+	assert.Empty(t, validateReplicas(3, nil, nil, path), "an emptyDir is per pod")
+	// Existing claims are checked by the controllers
+	assert.Empty(t, validateReplicas(3, nil, &k8schianetv1.StorageConfig{ChiaRoot: &k8schianetv1.ChiaRootConfig{PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "chia-root"}}}, path))
+
+	errs := validateReplicas(3, nil, storage(corev1.ReadWriteOnce), path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.storage.chiaRoot.persistentVolumeClaim.accessModes", errs[0].Field)
+
+	// Autoscaling counts its maxReplicas
+	errs = validateReplicas(1, &k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true), MaxReplicas: 5}, storage(corev1.ReadWriteOnce), path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.storage.chiaRoot.persistentVolumeClaim.accessModes", errs[0].Field)
+}
+
+func TestValidateFileserver(t *testing.T) {
+	path := field.NewPath("spec")
+	datalayer := func(fileserver k8schianetv1.FileserverConfig, storage *k8schianetv1.StorageConfig) k8schianetv1.ChiaDataLayer {
+		return k8schianetv1.ChiaDataLayer{Spec: k8schianetv1.ChiaDataLayerSpec{
+			CommonSpec:       k8schianetv1.CommonSpec{Storage: storage},
+			FileserverConfig: fileserver,
+		}}
+	}
+	serverFiles := func(pvc *k8schianetv1.PersistentVolumeClaimConfig) *k8schianetv1.StorageConfig {
+		return &k8schianetv1.StorageConfig{DataLayerServerFiles: &k8schianetv1.DataLayerServerFilesConfig{PersistentVolumeClaim: pvc}}
+	}
+
+	// A sidecar fileserver shares the datalayer's pod, so it can use any volume
+	assert.Empty(t, validateFileserver(datalayer(k8schianetv1.FileserverConfig{Enabled: ptr.To(true)}, nil), path))
+	// A disabled fileserver isn't run separately
+	assert.Empty(t, validateFileserver(datalayer(k8schianetv1.FileserverConfig{Replicas: ptr.To(int32(2))}, nil), path))
+	assert.Empty(t, validateFileserver(datalayer(k8schianetv1.FileserverConfig{Enabled: ptr.To(true), Replicas: ptr.To(int32(2))}, serverFiles(&k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "server-files"})), path))
+
+	// A fileserver in its own Deployment needs a claim it can share with the datalayer
+	errs := validateFileserver(datalayer(k8schianetv1.FileserverConfig{Enabled: ptr.To(true), Replicas: ptr.To(int32(1))}, nil), path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.storage.dataLayerServerFiles.persistentVolumeClaim", errs[0].Field)
+
+	errs = validateFileserver(datalayer(k8schianetv1.FileserverConfig{Enabled: ptr.To(true), Replicas: ptr.To(int32(1))}, serverFiles(&k8schianetv1.PersistentVolumeClaimConfig{
+		GenerateVolumeClaims: true,
+		AccessModes:          []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+		ResourceRequest:      "10Gi",
+	})), path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.storage.dataLayerServerFiles.persistentVolumeClaim.accessModes", errs[0].Field)
+
+	errs = validateFileserver(datalayer(k8schianetv1.FileserverConfig{Enabled: ptr.To(true), Autoscaling: &k8schianetv1.AutoscalingConfig{Enabled: ptr.To(true)}}, serverFiles(&k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "server-files"})), path)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.fileserver.autoscaling.maxReplicas", errs[0].Field)
+}
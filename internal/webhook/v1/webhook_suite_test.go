@@ -0,0 +1,239 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	k8schianetv2 "github.com/chia-network/chia-operator/api/v2"
+)
+
+// These tests use Ginkgo (BDD-style Go testing framework). Refer to
+// http://onsi.github.io/ginkgo/ to learn more about Ginkgo.
+
+var (
+	k8sClient client.Client
+	testEnv   *envtest.Environment
+	ctx       context.Context
+	cancel    context.CancelFunc
+)
+
+func TestWebhooks(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Webhook Suite")
+}
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+
+	ctx, cancel = context.WithCancel(context.Background())
+
+	// The schemes are registered before starting the test environment, so the CRDs of convertible kinds are installed with the conversion webhook
+	err := k8schianetv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = k8schianetv2.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = apiextensionsv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	By("bootstrapping test environment")
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "..", "config", "webhook")},
+		},
+	}
+
+	cfg, err := testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(k8sClient).NotTo(BeNil())
+
+	webhookInstallOptions := &testEnv.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme: scheme.Scheme,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    webhookInstallOptions.LocalServingHost,
+			Port:    webhookInstallOptions.LocalServingPort,
+			CertDir: webhookInstallOptions.LocalServingCertDir,
+		}),
+		LeaderElection: false,
+		Metrics:        metricsserver.Options{BindAddress: "0"},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupWebhooksWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		defer GinkgoRecover()
+		err = mgr.Start(ctx)
+		Expect(err).NotTo(HaveOccurred(), "failed to run manager")
+	}()
+
+	// Wait for the webhook server to serve
+	dialer := &net.Dialer{Timeout: time.Second}
+	addrPort := fmt.Sprintf("%s:%d", webhookInstallOptions.LocalServingHost, webhookInstallOptions.LocalServingPort)
+	Eventually(func() error {
+		conn, err := tls.DialWithDialer(dialer, "tcp", addrPort, &tls.Config{InsecureSkipVerify: true})
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}).Should(Succeed())
+})
+
+var _ = AfterSuite(func() {
+	cancel()
+	By("tearing down the test environment")
+	err := testEnv.Stop()
+	if err != nil {
+		// Log this error, don't care if the mock server fails to stop for our purposes but could be nice to know when it happens.
+		log.Print(err.Error())
+	}
+})
+
+var _ = Describe("Chia resource webhooks", func() {
+	It("admits a valid ChiaNode", func() {
+		node := &k8schianetv1.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid-node", Namespace: "default"},
+			Spec: k8schianetv1.ChiaNodeSpec{
+				ChiaConfig: k8schianetv1.ChiaNodeSpecChia{
+					CASecretName: "ca",
+					TrustedCIDRs: &[]string{"10.0.0.0/8"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+	})
+
+	It("rejects a ChiaNode with an invalid trusted CIDR", func() {
+		node := &k8schianetv1.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-node", Namespace: "default"},
+			Spec: k8schianetv1.ChiaNodeSpec{
+				ChiaConfig: k8schianetv1.ChiaNodeSpecChia{
+					CASecretName: "ca",
+					TrustedCIDRs: &[]string{"10.0.0.1"},
+				},
+			},
+		}
+		err := k8sClient.Create(ctx, node)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.chia.trustedCIDRs[0]"))
+	})
+
+	It("rejects a ChiaFarmer with both fullNodePeer and fullNodePeers", func() {
+		farmer := &k8schianetv1.ChiaFarmer{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-farmer", Namespace: "default"},
+			Spec: k8schianetv1.ChiaFarmerSpec{
+				ChiaConfig: k8schianetv1.ChiaFarmerSpecChia{
+					CASecretName:  "ca",
+					FullNodePeer:  ptr.To("node:8444"),
+					FullNodePeers: &[]k8schianetv1.Peer{{Host: "node", Port: 8444}},
+				},
+			},
+		}
+		err := k8sClient.Create(ctx, farmer)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.chia.fullNodePeers"))
+	})
+
+	It("rejects a ChiaCertificates whose Secret is the CA Secret", func() {
+		certs := &k8schianetv1.ChiaCertificates{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-certs", Namespace: "default"},
+			Spec: k8schianetv1.ChiaCertificatesSpec{
+				Secret:       "ca",
+				CASecretName: "ca",
+			},
+		}
+		err := k8sClient.Create(ctx, certs)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.secret"))
+	})
+
+	It("converts a ChiaNode created with the v2 API to v1", func() {
+		// v2 isn't served by the generated CRDs, so it's served here like the serve_v2 kustomize patch does
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "chianodes.k8s.chia.net"}, crd)).To(Succeed())
+		Expect(crd.Spec.Versions[1].Name).To(Equal("v2"))
+		crd.Spec.Versions[1].Served = true
+		Expect(k8sClient.Update(ctx, crd)).To(Succeed())
+
+		node := &k8schianetv2.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "v2-node", Namespace: "default"},
+			Spec: k8schianetv2.ChiaNodeSpec{
+				CommonSpec: k8schianetv2.CommonSpec{
+					Storage: &k8schianetv2.StorageConfig{
+						ChiaRoot: &k8schianetv2.VolumeConfig{
+							GeneratedClaim: &k8schianetv2.GeneratedClaimVolume{Size: resource.MustParse("300Gi")},
+						},
+					},
+				},
+				ChiaConfig: k8schianetv2.ChiaNodeSpecChia{
+					CASecretRef: k8schianetv2.SecretReference{Name: "ca"},
+				},
+			},
+		}
+		Eventually(func() error {
+			return k8sClient.Create(ctx, node)
+		}).Should(Succeed())
+
+		stored := &k8schianetv1.ChiaNode{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(node), stored)).To(Succeed())
+		Expect(stored.Spec.ChiaConfig.CASecretName).To(Equal("ca"))
+		Expect(stored.Spec.Storage.ChiaRoot.PersistentVolumeClaim.ResourceRequest).To(Equal("300Gi"))
+
+		// A v2 ChiaNode can't use an existing claim for CHIA_ROOT
+		invalid := &k8schianetv2.ChiaNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-v2-node", Namespace: "default"},
+			Spec: k8schianetv2.ChiaNodeSpec{
+				CommonSpec: k8schianetv2.CommonSpec{
+					Storage: &k8schianetv2.StorageConfig{
+						ChiaRoot: &k8schianetv2.VolumeConfig{
+							ExistingClaim: &k8schianetv2.ExistingClaimVolume{ClaimName: "chiaroot"},
+						},
+					},
+				},
+				ChiaConfig: k8schianetv2.ChiaNodeSpecChia{
+					CASecretRef: k8schianetv2.SecretReference{Name: "ca"},
+				},
+			},
+		}
+		err := k8sClient.Create(ctx, invalid)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	k8schianetv1 "github.com/chia-network/chia-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// causeFields returns the field paths of the causes of an Invalid API error
+func causeFields(t *testing.T, err error) []string {
+	require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+	var fields []string
+	for _, cause := range err.(apierrors.APIStatus).Status().Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	return fields
+}
+
+func TestChiaNodeCustomValidator(t *testing.T) {
+	v := &ChiaNodeCustomValidator{}
+	node := &k8schianetv1.ChiaNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "node", Namespace: "default"},
+		Spec: k8schianetv1.ChiaNodeSpec{
+			ChiaConfig: k8schianetv1.ChiaNodeSpecChia{
+				CASecretName: "ca",
+				TrustedCIDRs: &[]string{"10.0.0.0/8"},
+			},
+		},
+	}
+
+	_, err := v.ValidateCreate(context.TODO(), node)
+	require.NoError(t, err)
+
+	invalid := node.DeepCopy()
+	invalid.Spec.ChiaConfig.TrustedCIDRs = &[]string{"10.0.0.0/33"}
+	invalid.Spec.ChiaConfig.FullNodePeers = &[]k8schianetv1.Peer{{Port: 8444}}
+	_, err = v.ValidateCreate(context.TODO(), invalid)
+	assert.Equal(t, []string{"spec.chia.fullNodePeers[0].host", "spec.chia.trustedCIDRs[0]"}, causeFields(t, err))
+
+	_, err = v.ValidateUpdate(context.TODO(), node, invalid)
+	assert.True(t, apierrors.IsInvalid(err))
+
+	// Updates that don't change the spec of an already invalid resource are allowed
+	relabeled := invalid.DeepCopy()
+	relabeled.Labels = map[string]string{"updated": "true"}
+	_, err = v.ValidateUpdate(context.TODO(), invalid, relabeled)
+	assert.NoError(t, err)
+}
+
+func TestChiaFarmerCustomValidator(t *testing.T) {
+	v := &ChiaFarmerCustomValidator{}
+	farmer := &k8schianetv1.ChiaFarmer{
+		ObjectMeta: metav1.ObjectMeta{Name: "farmer", Namespace: "default"},
+		Spec: k8schianetv1.ChiaFarmerSpec{
+			ChiaConfig: k8schianetv1.ChiaFarmerSpecChia{
+				CASecretName:  "ca",
+				FullNodePeer:  ptr.To("node:8444"),
+				FullNodePeers: &[]k8schianetv1.Peer{{Host: "node", Port: 8444}},
+			},
+		},
+	}
+	farmer.Spec.Storage = &k8schianetv1.StorageConfig{
+		ChiaRoot: &k8schianetv1.ChiaRootConfig{
+			PersistentVolumeClaim: &k8schianetv1.PersistentVolumeClaimConfig{ClaimName: "chiaroot"},
+			HostPathVolume:        &k8schianetv1.HostPathVolumeConfig{Path: "/mnt/chiaroot"},
+		},
+	}
+
+	_, err := v.ValidateCreate(context.TODO(), farmer)
+	assert.Equal(t, []string{"spec.storage.chiaRoot.hostPathVolume", "spec.chia.fullNodePeers"}, causeFields(t, err))
+}
+
+func TestChiaCertificatesCustomValidator(t *testing.T) {
+	v := &ChiaCertificatesCustomValidator{}
+	certs := &k8schianetv1.ChiaCertificates{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Spec:       k8schianetv1.ChiaCertificatesSpec{CASecretName: "ca"},
+	}
+
+	_, err := v.ValidateCreate(context.TODO(), certs)
+	require.NoError(t, err)
+
+	// The Secret can't be the CA Secret
+	invalid := certs.DeepCopy()
+	invalid.Spec.Secret = "ca"
+	_, err = v.ValidateCreate(context.TODO(), invalid)
+	assert.Equal(t, []string{"spec.secret"}, causeFields(t, err))
+
+	// The Secret defaults to the resource's name, which can't be the CA Secret either
+	invalid = certs.DeepCopy()
+	invalid.Name = "ca"
+	_, err = v.ValidateCreate(context.TODO(), invalid)
+	assert.Equal(t, []string{"metadata.name"}, causeFields(t, err))
+}
+
+func TestChiaCACustomValidator(t *testing.T) {
+	v := &ChiaCACustomValidator{}
+	ca := &k8schianetv1.ChiaCA{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+		Spec: k8schianetv1.ChiaCASpec{
+			Adopt: &k8schianetv1.ChiaCAAdopt{SecretRef: &k8schianetv1.ChiaCAAdoptSecretRef{Name: "existing-ca"}},
+		},
+	}
+
+	warnings, err := v.ValidateCreate(context.TODO(), ca)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	invalid := ca.DeepCopy()
+	invalid.Spec.Adopt.Certificate = "-----BEGIN CERTIFICATE-----"
+	_, err = v.ValidateCreate(context.TODO(), invalid)
+	assert.Equal(t, []string{"spec.adopt.secretRef"}, causeFields(t, err))
+
+	invalid.Spec.Adopt.SecretRef = nil
+	_, err = v.ValidateCreate(context.TODO(), invalid)
+	assert.Equal(t, []string{"spec.adopt.privateKey"}, causeFields(t, err))
+
+	// An issuerRef without the CertManager backend is unused
+	unused := ca.DeepCopy()
+	unused.Spec.Backend = k8schianetv1.CertificateBackendOperator
+	unused.Spec.IssuerRef = &k8schianetv1.CertManagerIssuerRef{Name: "issuer"}
+	warnings, err = v.ValidateCreate(context.TODO(), unused)
+	require.NoError(t, err)
+	assert.Len(t, warnings, 1)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 Chia Network Inc.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhooksWithManager registers the validating webhooks of every kind of Chia resource with the manager's webhook server.
+// The conversion webhook is registered along with them when the v2 API is in the manager's scheme.
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	setups := []struct {
+		kind  string
+		setup func(ctrl.Manager) error
+	}{
+		{"ChiaCA", SetupChiaCAWebhookWithManager},
+		{"ChiaCertificates", SetupChiaCertificatesWebhookWithManager},
+		{"ChiaCrawler", SetupChiaCrawlerWebhookWithManager},
+		{"ChiaDataLayer", SetupChiaDataLayerWebhookWithManager},
+		{"ChiaFarmer", SetupChiaFarmerWebhookWithManager},
+		{"ChiaHarvester", SetupChiaHarvesterWebhookWithManager},
+		{"ChiaIntroducer", SetupChiaIntroducerWebhookWithManager},
+		{"ChiaKey", SetupChiaKeyWebhookWithManager},
+		{"ChiaNetwork", SetupChiaNetworkWebhookWithManager},
+		{"ChiaNode", SetupChiaNodeWebhookWithManager},
+		{"ChiaSeeder", SetupChiaSeederWebhookWithManager},
+		{"ChiaTimelord", SetupChiaTimelordWebhookWithManager},
+		{"ChiaWallet", SetupChiaWalletWebhookWithManager},
+	}
+	for _, s := range setups {
+		if err := s.setup(mgr); err != nil {
+			return fmt.Errorf("error setting up %s webhook: %v", s.kind, err)
+		}
+	}
+	return nil
+}